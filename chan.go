@@ -0,0 +1,77 @@
+package itrz
+
+import (
+	"context"
+)
+
+// FromChan returns a Seq that yields the elements received from the channel until it is closed.
+// If the consumer stops iterating early then the remaining elements are left in the channel, so
+// ranging over the Seq again resumes receiving from where the previous iteration stopped.
+func FromChan[A any](ch <-chan A) Seq[A] {
+	return func(yield func(A) bool) {
+		for a := range ch {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan starts a goroutine that sends the elements yielded by the Seq to the returned channel,
+// which has the specified buffer size. The channel is closed once the Seq is exhausted or the
+// context.Context is cancelled, at which point the goroutine exits. A consumer that stops
+// receiving before the channel is closed must cancel the context.Context, otherwise the goroutine
+// is leaked. Panics if buffer is negative.
+func ToChan[A any](ctx context.Context, seq Seq[A], buffer int) <-chan A {
+	if buffer < 0 {
+		panic("buffer must not be negative")
+	}
+
+	ch := make(chan A, buffer)
+
+	go func() {
+		defer close(ch)
+
+		for a := range WithContext(ctx, seq) {
+			select {
+			case ch <- a:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Merge returns a Seq that ranges over each of the specified sequences concurrently, in its own
+// goroutine, and yields their elements in the order in which they arrive. The elements of each
+// sequence are yielded in their original order, but the interleaving between sequences is not
+// deterministic. When the consumer stops iterating, all goroutines started by the Seq have exited
+// before the iteration returns, so a sequence that blocks while producing an element delays
+// the return. A panic raised by any of the sequences is re-raised in the goroutine that is
+// ranging over the returned Seq.
+func Merge[A any](seqs ...Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		fan := newFanIn[A](0)
+
+		for _, seq := range seqs {
+			fan.spawn(func() {
+				for a := range seq {
+					if !fan.send(0, a) {
+						return
+					}
+				}
+			})
+		}
+
+		fan.closeWhenDone()
+		defer fan.stop()
+
+		for _, a := range fan.received() {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
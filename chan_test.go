@@ -0,0 +1,184 @@
+package itrz_test
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_FromChan(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+	}{
+		"empty":    {values: []int{}},
+		"elements": {values: []int{1, 2, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan int, len(test.values))
+			for _, n := range test.values {
+				ch <- n
+			}
+
+			close(ch)
+
+			assert.Equal(t, test.values, itrz.FromChan(ch).ToSlice())
+		})
+	}
+}
+
+func Test_FromChan_EarlyTermination(t *testing.T) {
+	ch := make(chan int, 4)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	ch <- 4
+	close(ch)
+
+	seq := itrz.FromChan(ch)
+
+	assert.Equal(t, []int{1, 2}, seq.Limit(2).ToSlice())
+	assert.Equal(t, []int{3, 4}, seq.ToSlice(), "expected second iteration to resume receiving")
+}
+
+func Test_ToChan(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		buffer int
+	}{
+		"empty":      {values: []int{}},
+		"unbuffered": {values: []int{1, 2, 3}},
+		"buffered":   {values: []int{1, 2, 3}, buffer: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			ch := itrz.ToChan(context.Background(), itrz.All(test.values), test.buffer)
+
+			res := make([]int, 0)
+			for n := range ch {
+				res = append(res, n)
+			}
+
+			assert.Equal(t, test.values, res)
+			assertNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func Test_ToChan_Cancel(t *testing.T) {
+	tests := map[string]struct {
+		buffer int
+	}{
+		"unbuffered": {},
+		"buffered":   {buffer: 4},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			next := 0
+			source := itrz.Generate(func() int {
+				next = next + 1
+				return next
+			})
+
+			ch := itrz.ToChan(ctx, source, test.buffer)
+
+			assert.Equal(t, 1, <-ch)
+			assert.Equal(t, 2, <-ch)
+
+			cancel()
+
+			// the channel may still hold buffered elements, but it must be closed eventually
+			for range ch {
+			}
+
+			assertNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func Test_ToChan_InvalidBuffer(t *testing.T) {
+	assert.Panics(t, func() { itrz.ToChan(context.Background(), itrz.Of(1), -1) })
+}
+
+func Test_Merge(t *testing.T) {
+	tests := map[string]struct {
+		seqs   []itrz.Seq[int]
+		expect []int
+	}{
+		"no sequences":    {seqs: nil, expect: []int{}},
+		"empty sequences": {seqs: []itrz.Seq[int]{itrz.Empty[int](), itrz.Empty[int]()}, expect: []int{}},
+		"one sequence":    {seqs: []itrz.Seq[int]{itrz.Of(1, 2, 3)}, expect: []int{1, 2, 3}},
+		"many sequences":  {seqs: []itrz.Seq[int]{itrz.Of(1, 4), itrz.Empty[int](), itrz.Of(2, 5, 6), itrz.Of(3)}, expect: []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			res := itrz.Merge(test.seqs...).ToSlice()
+			slices.Sort(res)
+
+			assert.Equal(t, test.expect, res)
+			assertNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func Test_Merge_PreservesOrderWithinSeq(t *testing.T) {
+	evens := itrz.GenerateWithLast(-2, func(n int) int { return n + 2 }).Limit(50)
+	odds := itrz.GenerateWithLast(-1, func(n int) int { return n + 2 }).Limit(50)
+
+	res := itrz.Merge(evens, odds).ToSlice()
+
+	assert.Len(t, res, 100)
+	assert.True(t, itrz.IsSorted(itrz.All(res).Filter(isOdd)))
+	assert.True(t, itrz.IsSorted(itrz.All(res).Filter(func(n int) bool { return !isOdd(n) })))
+}
+
+func Test_Merge_NoGoroutineLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ones := itrz.Generate(func() int { return 1 })
+	twos := itrz.Generate(func() int { return 2 })
+
+	for range itrz.Merge(ones, twos, itrz.Of(3)) {
+		break
+	}
+
+	res := itrz.Merge(ones, twos).Limit(10).ToSlice()
+
+	assert.Len(t, res, 10)
+	assertNoGoroutineLeak(t, before)
+}
+
+func Test_Merge_Panics(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	failing := itrz.Map(itrz.Of(1, 2, 3), func(n int) int {
+		if n == 2 {
+			panic("boom")
+		}
+
+		return n
+	})
+
+	assert.PanicsWithValue(t, "boom", func() {
+		itrz.Merge(failing, itrz.Generate(func() int { return 0 })).ForEach(func(int) {})
+	})
+
+	assertNoGoroutineLeak(t, before)
+}
@@ -0,0 +1,26 @@
+package itrz
+
+import (
+	"github.com/dustin10/itrz/fn"
+)
+
+// Collector describes a mutable reduction of the elements of a Seq of type A into a result of
+// type R by way of an intermediate accumulation of type Acc. Ready made Collector values can be
+// found in the collectors package.
+type Collector[A, Acc, R any] struct {
+	// Supplier creates a new, empty accumulation.
+	Supplier fn.Factory[Acc]
+	// Accumulator folds an element into the accumulation and returns the updated accumulation.
+	Accumulator fn.Function2[A, Acc, Acc]
+	// Combiner merges two partial accumulations, e.g. ones collected from separate shards of a
+	// data set, into one.
+	Combiner fn.Function2[Acc, Acc, Acc]
+	// Finisher transforms the final accumulation into the result.
+	Finisher fn.Function[Acc, R]
+}
+
+// Collect performs a mutable reduction on the elements of the Seq using the specified Collector
+// and returns the result.
+func Collect[A, Acc, R any](seq Seq[A], c Collector[A, Acc, R]) R {
+	return c.Finisher(Reduce(seq, c.Supplier(), c.Accumulator))
+}
@@ -0,0 +1,41 @@
+package itrz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_Collect(t *testing.T) {
+	supplied := 0
+
+	c := itrz.Collector[int, []int, int]{
+		Supplier: func() []int {
+			supplied = supplied + 1
+			return make([]int, 0)
+		},
+		Accumulator: func(n int, acc []int) []int { return append(acc, n) },
+		Combiner:    func(a, b []int) []int { return append(a, b...) },
+		Finisher:    func(acc []int) int { return len(acc) },
+	}
+
+	tests := map[string]struct {
+		values   []int
+		expected int
+	}{
+		"empty":     {values: []int{}},
+		"nil":       {values: nil},
+		"non-empty": {values: []int{1, 2, 3}, expected: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			supplied = 0
+
+			assert.Equal(t, test.expected, itrz.Collect(itrz.All(test.values), c))
+			assert.Equal(t, 1, supplied)
+		})
+	}
+}
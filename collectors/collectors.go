@@ -0,0 +1,243 @@
+package collectors
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/fn"
+	"github.com/dustin10/itrz/set"
+)
+
+// Counting returns an itrz.Collector that counts the number of elements.
+func Counting[A any]() itrz.Collector[A, int, int] {
+	return itrz.Collector[A, int, int]{
+		Supplier:    func() int { return 0 },
+		Accumulator: func(_ A, count int) int { return count + 1 },
+		Combiner:    func(a, b int) int { return a + b },
+		Finisher:    identity[int],
+	}
+}
+
+// Filtering returns an itrz.Collector that only passes the elements that match the specified
+// fn.Predicate to the downstream itrz.Collector.
+func Filtering[A, Acc, R any](p fn.Predicate[A], downstream itrz.Collector[A, Acc, R]) itrz.Collector[A, Acc, R] {
+	return itrz.Collector[A, Acc, R]{
+		Supplier: downstream.Supplier,
+		Accumulator: func(a A, acc Acc) Acc {
+			if !p(a) {
+				return acc
+			}
+
+			return downstream.Accumulator(a, acc)
+		},
+		Combiner: downstream.Combiner,
+		Finisher: downstream.Finisher,
+	}
+}
+
+// GroupingBy returns an itrz.Collector that groups the elements by the key produced by the
+// specified fn.Function and collects the elements of each group using the downstream
+// itrz.Collector.
+func GroupingBy[A any, K comparable, Acc, R any](key fn.Function[A, K], downstream itrz.Collector[A, Acc, R]) itrz.Collector[A, map[K]Acc, map[K]R] {
+	return itrz.Collector[A, map[K]Acc, map[K]R]{
+		Supplier: func() map[K]Acc { return make(map[K]Acc) },
+		Accumulator: func(a A, groups map[K]Acc) map[K]Acc {
+			k := key(a)
+
+			acc, exists := groups[k]
+			if !exists {
+				acc = downstream.Supplier()
+			}
+
+			groups[k] = downstream.Accumulator(a, acc)
+
+			return groups
+		},
+		Combiner: func(a, b map[K]Acc) map[K]Acc {
+			for k, acc := range b {
+				if existing, exists := a[k]; exists {
+					a[k] = downstream.Combiner(existing, acc)
+				} else {
+					a[k] = acc
+				}
+			}
+
+			return a
+		},
+		Finisher: func(groups map[K]Acc) map[K]R {
+			result := make(map[K]R, len(groups))
+			for k, acc := range groups {
+				result[k] = downstream.Finisher(acc)
+			}
+
+			return result
+		},
+	}
+}
+
+// Joining returns an itrz.Collector that concatenates the string elements, in the order they
+// are yielded, separated by the specified separator.
+func Joining(sep string) itrz.Collector[string, []string, string] {
+	return itrz.Collector[string, []string, string]{
+		Supplier:    func() []string { return make([]string, 0) },
+		Accumulator: appendTo[string],
+		Combiner:    concat[string],
+		Finisher:    func(ss []string) string { return strings.Join(ss, sep) },
+	}
+}
+
+// Mapping returns an itrz.Collector that applies the specified fn.Function to each element
+// before passing it to the downstream itrz.Collector.
+func Mapping[A, B, Acc, R any](f fn.Function[A, B], downstream itrz.Collector[B, Acc, R]) itrz.Collector[A, Acc, R] {
+	return itrz.Collector[A, Acc, R]{
+		Supplier:    downstream.Supplier,
+		Accumulator: func(a A, acc Acc) Acc { return downstream.Accumulator(f(a), acc) },
+		Combiner:    downstream.Combiner,
+		Finisher:    downstream.Finisher,
+	}
+}
+
+// Stats contains summary statistics of a collection of numbers.
+type Stats[A itrz.Number] struct {
+	// Count is the number of values.
+	Count int
+	// Sum is the sum of the values.
+	Sum A
+	// Min is the smallest value or zero if there are no values.
+	Min A
+	// Max is the largest value or zero if there are no values.
+	Max A
+}
+
+// Average returns the arithmetic mean of the values or zero if there are no values.
+func (s Stats[A]) Average() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+
+	return float64(s.Sum) / float64(s.Count)
+}
+
+// Summarizing returns an itrz.Collector that computes the Stats of the numeric elements.
+func Summarizing[A itrz.Number]() itrz.Collector[A, Stats[A], Stats[A]] {
+	return itrz.Collector[A, Stats[A], Stats[A]]{
+		Supplier: func() Stats[A] { return Stats[A]{} },
+		Accumulator: func(a A, s Stats[A]) Stats[A] {
+			if s.Count == 0 || a < s.Min {
+				s.Min = a
+			}
+
+			if s.Count == 0 || a > s.Max {
+				s.Max = a
+			}
+
+			s.Count = s.Count + 1
+			s.Sum = s.Sum + a
+
+			return s
+		},
+		Combiner: func(a, b Stats[A]) Stats[A] {
+			if a.Count == 0 {
+				return b
+			}
+
+			if b.Count == 0 {
+				return a
+			}
+
+			return Stats[A]{
+				Count: a.Count + b.Count,
+				Sum:   a.Sum + b.Sum,
+				Min:   min(a.Min, b.Min),
+				Max:   max(a.Max, b.Max),
+			}
+		},
+		Finisher: identity[Stats[A]],
+	}
+}
+
+// Tee is the accumulation of an itrz.Collector created by Teeing. It holds the accumulations of
+// both of the downstream itrz.Collector values.
+type Tee[Acc1, Acc2 any] struct {
+	First  Acc1
+	Second Acc2
+}
+
+// Teeing returns an itrz.Collector that passes each element to both of the specified downstream
+// itrz.Collector values and merges their results with the specified fn.Function2. This allows
+// two aggregations to be computed in a single pass over a Seq.
+func Teeing[A, Acc1, R1, Acc2, R2, R any](c1 itrz.Collector[A, Acc1, R1], c2 itrz.Collector[A, Acc2, R2], merger fn.Function2[R1, R2, R]) itrz.Collector[A, Tee[Acc1, Acc2], R] {
+	return itrz.Collector[A, Tee[Acc1, Acc2], R]{
+		Supplier: func() Tee[Acc1, Acc2] {
+			return Tee[Acc1, Acc2]{First: c1.Supplier(), Second: c2.Supplier()}
+		},
+		Accumulator: func(a A, acc Tee[Acc1, Acc2]) Tee[Acc1, Acc2] {
+			return Tee[Acc1, Acc2]{First: c1.Accumulator(a, acc.First), Second: c2.Accumulator(a, acc.Second)}
+		},
+		Combiner: func(a, b Tee[Acc1, Acc2]) Tee[Acc1, Acc2] {
+			return Tee[Acc1, Acc2]{First: c1.Combiner(a.First, b.First), Second: c2.Combiner(a.Second, b.Second)}
+		},
+		Finisher: func(acc Tee[Acc1, Acc2]) R {
+			return merger(c1.Finisher(acc.First), c2.Finisher(acc.Second))
+		},
+	}
+}
+
+// ToMap returns an itrz.Collector that accumulates the elements into a map using the specified
+// fn.Function values to produce the keys and values. If multiple elements produce the same key
+// then the value of the last one is retained.
+func ToMap[A any, K comparable, V any](key fn.Function[A, K], value fn.Function[A, V]) itrz.Collector[A, map[K]V, map[K]V] {
+	return itrz.Collector[A, map[K]V, map[K]V]{
+		Supplier: func() map[K]V { return make(map[K]V) },
+		Accumulator: func(a A, m map[K]V) map[K]V {
+			m[key(a)] = value(a)
+			return m
+		},
+		Combiner: func(a, b map[K]V) map[K]V {
+			maps.Copy(a, b)
+			return a
+		},
+		Finisher: identity[map[K]V],
+	}
+}
+
+// ToSet returns an itrz.Collector that accumulates the elements into a set.Set, applying any
+// set.Options that are specified.
+func ToSet[A comparable](opts ...set.Option) itrz.Collector[A, set.Set[A], set.Set[A]] {
+	return itrz.Collector[A, set.Set[A], set.Set[A]]{
+		Supplier: func() set.Set[A] { return set.New[A](opts...) },
+		Accumulator: func(a A, s set.Set[A]) set.Set[A] {
+			s.Add(a)
+			return s
+		},
+		Combiner: func(a, b set.Set[A]) set.Set[A] {
+			b.All().DrainTo(&a)
+			return a
+		},
+		Finisher: identity[set.Set[A]],
+	}
+}
+
+// ToSlice returns an itrz.Collector that accumulates the elements into a slice in the order
+// they are yielded.
+func ToSlice[A any]() itrz.Collector[A, []A, []A] {
+	return itrz.Collector[A, []A, []A]{
+		Supplier:    func() []A { return make([]A, 0) },
+		Accumulator: appendTo[A],
+		Combiner:    concat[A],
+		Finisher:    identity[[]A],
+	}
+}
+
+func appendTo[A any](a A, as []A) []A {
+	return append(as, a)
+}
+
+func concat[A any](a, b []A) []A {
+	return append(a, b...)
+}
+
+func identity[A any](a A) A {
+	return a
+}
@@ -0,0 +1,166 @@
+package collectors_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/collectors"
+	"github.com/dustin10/itrz/set"
+)
+
+func Test_Counting(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected int
+	}{
+		"empty":     {values: []int{}},
+		"non-empty": {values: []int{1, 2, 3}, expected: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Collect(itrz.All(test.values), collectors.Counting[int]()))
+		})
+	}
+}
+
+func Test_Filtering(t *testing.T) {
+	c := collectors.Filtering(isOdd, collectors.ToSlice[int]())
+
+	assert.Equal(t, []int{1, 3}, itrz.Collect(itrz.Of(1, 2, 3, 4), c))
+}
+
+func Test_GroupingBy(t *testing.T) {
+	c := collectors.GroupingBy(isOdd, collectors.Counting[int]())
+
+	res := itrz.Collect(itrz.Of(1, 2, 3, 4, 5), c)
+
+	assert.Equal(t, map[bool]int{true: 3, false: 2}, res)
+}
+
+func Test_GroupingBy_Nested(t *testing.T) {
+	c := collectors.GroupingBy(isOdd, collectors.Mapping(strconv.Itoa, collectors.Joining("|")))
+
+	res := itrz.Collect(itrz.Of(1, 2, 3, 4, 5), c)
+
+	assert.Equal(t, map[bool]string{true: "1|3|5", false: "2|4"}, res)
+}
+
+func Test_Joining(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		sep      string
+		expected string
+	}{
+		"empty":     {values: []string{}, sep: ","},
+		"one":       {values: []string{"a"}, sep: ",", expected: "a"},
+		"non-empty": {values: []string{"a", "b", "c"}, sep: ", ", expected: "a, b, c"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Collect(itrz.All(test.values), collectors.Joining(test.sep)))
+		})
+	}
+}
+
+func Test_Mapping(t *testing.T) {
+	c := collectors.Mapping(strconv.Itoa, collectors.ToSlice[string]())
+
+	assert.Equal(t, []string{"1", "2"}, itrz.Collect(itrz.Of(1, 2), c))
+}
+
+func Test_Summarizing(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected collectors.Stats[int]
+		average  float64
+	}{
+		"empty":     {values: []int{}, expected: collectors.Stats[int]{}},
+		"one":       {values: []int{-2}, expected: collectors.Stats[int]{Count: 1, Sum: -2, Min: -2, Max: -2}, average: -2},
+		"non-empty": {values: []int{3, 1, 4, 1, 5}, expected: collectors.Stats[int]{Count: 5, Sum: 14, Min: 1, Max: 5}, average: 2.8},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := itrz.Collect(itrz.All(test.values), collectors.Summarizing[int]())
+
+			assert.Equal(t, test.expected, res)
+			assert.InDelta(t, test.average, res.Average(), 1e-9)
+		})
+	}
+}
+
+func Test_Teeing(t *testing.T) {
+	average := collectors.Teeing(summing(), collectors.Counting[float64](), func(sum float64, count int) float64 {
+		return sum / float64(count)
+	})
+
+	assert.Equal(t, 2.5, itrz.Collect(itrz.Of(1.0, 2.0, 3.0, 4.0), average))
+}
+
+func Test_ToMap(t *testing.T) {
+	c := collectors.ToMap(strconv.Itoa, func(n int) int { return n * n })
+
+	res := itrz.Collect(itrz.Of(1, 2, 3, 2), c)
+
+	assert.Equal(t, map[string]int{"1": 1, "2": 4, "3": 9}, res)
+}
+
+func Test_ToSet(t *testing.T) {
+	res := itrz.Collect(itrz.Of(1, 2, 2, 3), collectors.ToSet[int]())
+
+	assert.True(t, res.Equal(set.FromSlice([]int{1, 2, 3})))
+}
+
+func Test_ToSlice(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+	}{
+		"empty":     {values: []int{}},
+		"non-empty": {values: []int{1, 2, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.values, itrz.Collect(itrz.All(test.values), collectors.ToSlice[int]()))
+		})
+	}
+}
+
+func Test_Combiners(t *testing.T) {
+	combine := func(c itrz.Collector[int, collectors.Tee[map[bool][]int, collectors.Stats[int]], string], as, bs []int) string {
+		accA := itrz.Reduce(itrz.All(as), c.Supplier(), c.Accumulator)
+		accB := itrz.Reduce(itrz.All(bs), c.Supplier(), c.Accumulator)
+
+		return c.Finisher(c.Combiner(accA, accB))
+	}
+
+	c := collectors.Teeing(
+		collectors.GroupingBy(isOdd, collectors.ToSlice[int]()),
+		collectors.Summarizing[int](),
+		func(groups map[bool][]int, stats collectors.Stats[int]) string {
+			return fmt.Sprintf("%d:%d:%d:%d:%d", len(groups[true]), len(groups[false]), stats.Sum, stats.Min, stats.Max)
+		},
+	)
+
+	assert.Equal(t, "3:2:15:1:5", combine(c, []int{2, 5, 3}, []int{1, 4}))
+	assert.Equal(t, "1:0:1:1:1", combine(c, []int{}, []int{1}))
+}
+
+func summing() itrz.Collector[float64, float64, float64] {
+	return itrz.Collector[float64, float64, float64]{
+		Supplier:    func() float64 { return 0 },
+		Accumulator: func(a, sum float64) float64 { return a + sum },
+		Combiner:    func(a, b float64) float64 { return a + b },
+		Finisher:    func(sum float64) float64 { return sum },
+	}
+}
+
+func isOdd(n int) bool {
+	return n%2 == 1
+}
@@ -0,0 +1,92 @@
+package itrz_test
+
+import (
+	"cmp"
+	"context"
+	"testing"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/itrztest"
+	"github.com/dustin10/itrz/maybe"
+)
+
+// Test_Reiterable ensures that every operator scopes its state to a single iteration, so that
+// ranging over the same Seq more than once, including after stopping early, yields the same
+// elements each time, and that it honours the rest of the contract checked by itrztest.
+func Test_Reiterable(t *testing.T) {
+	source := func() itrz.Seq[int] { return itrz.Of(3, 1, 4, 1, 5, 9, 2, 6) }
+	inc := func(n int) int { return n + 1 }
+
+	tests := map[string]itrz.Seq[int]{
+		"All":              itrz.All([]int{1, 2, 3}),
+		"Of":               source(),
+		"Concat":           itrz.Concat(source(), source()),
+		"CatMaybes":        itrz.CatMaybes(itrz.Map(source(), evenMaybe)),
+		"Distinct":         itrz.Distinct(source()),
+		"Empty":            itrz.Empty[int](),
+		"Filter":           source().Filter(isOdd),
+		"FilterMap":        itrz.FilterMap(source(), evenMaybe),
+		"FlatMap":          itrz.FlatMap(source(), func(n int) itrz.Seq[int] { return itrz.Of(n, n) }),
+		"FromMaybe":        itrz.FromMaybe(maybe.Just(1)),
+		"GenerateWithLast": itrz.GenerateWithLast(0, inc).Limit(5),
+		"Limit":            source().Limit(3),
+		"Map":              itrz.Map(source(), inc),
+		"Memoize":          memoize(t, source()),
+		"Peek":             source().Peek(func(int) {}),
+		"Skip":             source().Skip(3),
+		"TakeWhile":        source().TakeWhile(func(n int) bool { return n < 5 }),
+		"TakeUntil":        source().TakeUntil(func(n int) bool { return n > 4 }),
+		"Chunk":            itrz.Map(itrz.Chunk(source(), 3), sumSlice),
+		"Window":           itrz.Map(itrz.Window(source(), 3, 2, itrz.WithPartialWindows()), sumSlice),
+		"ParallelMap":      itrz.ParallelMap(source(), inc, itrz.WithWorkers(2)),
+		"Merge":            itrz.Merge(source()),
+		"Range":            itrz.Range(0, 10, 3),
+		"RangeInclusive":   itrz.RangeInclusive(10, 0, -4),
+		"WithContext":      itrz.WithContext(context.Background(), source()),
+		"Sorted":           itrz.Sorted(source()),
+		"TopK":             itrz.TopK(source(), 3, cmp.Compare[int]),
+		"MergeSorted":      itrz.MergeSorted(itrz.Sorted(source()), itrz.Of(0, 7)),
+		"Zip":              itrz.Map2(itrz.Zip(source(), source().Skip(1)), add),
+		"ZipToShortest":    itrz.Map2(itrz.ZipToShortest(source(), source().Skip(1)), add),
+		"ZipStrict":        itrz.Map2(itrz.ZipStrict(source(), source()), add),
+		"FlatMap2":         itrz.FlatMap2(itrz.Zip(source(), source()), func(a, b int) itrz.Seq[int] { return itrz.Of(a, b) }),
+		"Seq2 Filter":      itrz.Map2(itrz.Zip(source(), source()).Filter(func(a, _ int) bool { return a > 2 }), add),
+		"Seq2 Limit":       itrz.Map2(itrz.Zip(source(), source()).Limit(3), add),
+		"Seq2 Skip":        itrz.Map2(itrz.Zip(source(), source()).Skip(3), add),
+		"Seq2 TakeWhile":   itrz.Map2(itrz.Zip(source(), source()).TakeWhile(func(a, _ int) bool { return a < 5 }), add),
+		"Seq2 Swap":        itrz.Map2(itrz.Zip(source(), source().Skip(1)).Swap(), sub),
+		"Seq2 Keys":        itrz.Zip(source(), source()).Keys(),
+		"Seq2 Values":      itrz.Zip(source(), source()).Values(),
+		"CountBySeq":       itrz.Map2(itrz.CountBySeq(source(), isOdd), func(_ bool, n int) int { return n }),
+		"FrequenciesSeq":   itrz.Map2(itrz.FrequenciesSeq(source()), add),
+		"GroupBySeq":       itrz.Map2(itrz.GroupBySeq(source(), isOdd), func(_ bool, ns []int) int { return len(ns) }),
+		"GroupAdjacent":    itrz.Map2(itrz.GroupAdjacent(source(), isOdd), func(_ bool, ns []int) int { return len(ns) }),
+		"SeqE Values":      itrz.MapE(itrz.Infallible(source()), func(n int) (int, error) { return n, nil }).Values(new(error)),
+	}
+
+	for name, seq := range tests {
+		t.Run(name, func(t *testing.T) {
+			itrztest.CheckSeq(t, seq)
+		})
+	}
+}
+
+// memoize returns the Seq returned by itrz.Memoize, releasing its source when the test completes.
+func memoize(t *testing.T, seq itrz.Seq[int]) itrz.Seq[int] {
+	memoized, stop := itrz.Memoize(seq)
+	t.Cleanup(stop)
+
+	return memoized
+}
+
+func sumSlice(ns []int) int {
+	return itrz.Sum(itrz.All(ns))
+}
+
+func add(a, b int) int {
+	return a + b
+}
+
+func sub(a, b int) int {
+	return a - b
+}
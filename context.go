@@ -0,0 +1,85 @@
+package itrz
+
+import (
+	"context"
+
+	"github.com/dustin10/itrz/fn"
+)
+
+// WithContext returns a Seq that yields the elements of the specified Seq until the
+// context.Context is cancelled. The context.Context is checked before each element is
+// yielded, so a source that blocks while producing an element will not be interrupted.
+func WithContext[A any](ctx context.Context, seq Seq[A]) Seq[A] {
+	return withContext(ctx, seq, nil)
+}
+
+// withContext returns a Seq that behaves like WithContext and, if stopped is not nil, records
+// whether the iteration was stopped because the context.Context was cancelled, as opposed to the
+// Seq being exhausted or the consumer stopping.
+func withContext[A any](ctx context.Context, seq Seq[A], stopped *bool) Seq[A] {
+	cancelled := func() bool {
+		if ctx.Err() == nil {
+			return false
+		}
+
+		if stopped != nil {
+			*stopped = true
+		}
+
+		return true
+	}
+
+	return func(yield func(A) bool) {
+		if cancelled() {
+			return
+		}
+
+		for a := range seq {
+			if cancelled() || !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachCtx applies the given fn.Consumer to each element yielded by the Seq until the
+// context.Context is cancelled. Returns the error from the context.Context if its cancellation
+// stopped the iteration, a context.Context cancelled after the last element has been consumed
+// is not reported.
+func (s Seq[A]) ForEachCtx(ctx context.Context, c fn.Consumer[A]) error {
+	stopped := false
+	for a := range withContext(ctx, s, &stopped) {
+		c(a)
+	}
+
+	return ctxErr(ctx, stopped)
+}
+
+// ReduceCtx performs a reduction on the elements of the Seq in the same manner as Reduce,
+// but stops when the context.Context is cancelled. Returns the value reduced so far along
+// with the error from the context.Context if its cancellation stopped the reduction.
+func ReduceCtx[A, B any](ctx context.Context, seq Seq[A], identity B, f fn.Function2[A, B, B]) (B, error) {
+	stopped := false
+	result := Reduce(withContext(ctx, seq, &stopped), identity, f)
+
+	return result, ctxErr(ctx, stopped)
+}
+
+// ToSliceCtx returns a slice containing the elements of the Seq that were yielded before the
+// context.Context was cancelled, along with the error from the context.Context if its
+// cancellation stopped the iteration.
+func (s Seq[A]) ToSliceCtx(ctx context.Context) ([]A, error) {
+	stopped := false
+	as := withContext(ctx, s, &stopped).ToSlice()
+
+	return as, ctxErr(ctx, stopped)
+}
+
+// ctxErr returns the error from the context.Context if its cancellation stopped an iteration.
+func ctxErr(ctx context.Context, stopped bool) error {
+	if !stopped {
+		return nil
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,148 @@
+package itrz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_WithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	next := 0
+	s := itrz.Generate(func() int {
+		next = next + 1
+		if next == 5 {
+			cancel()
+		}
+
+		return next
+	})
+
+	res := consumeSeq(itrz.WithContext(ctx, s))
+
+	assert.Equal(t, []int{1, 2, 3, 4}, res)
+}
+
+func Test_WithContext_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	s := itrz.Of(1, 2, 3).Peek(func(int) { called = true })
+
+	assert.Equal(t, 0, countElems(itrz.WithContext(ctx, s)))
+	assert.False(t, called)
+}
+
+func Test_WithContext_NotCancelled(t *testing.T) {
+	s := itrz.WithContext(context.Background(), itrz.Of(1, 2, 3))
+
+	assert.Equal(t, []int{1, 2, 3}, consumeSeq(s))
+}
+
+func Test_Seq_ForEachCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	res := make([]int, 0)
+	err := itrz.GenerateWithLast(0, func(n int) int { return n + 1 }).ForEachCtx(ctx, func(n int) {
+		res = append(res, n)
+		if n == 3 {
+			cancel()
+		}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	err = itrz.Of(1, 2, 3).ForEachCtx(context.Background(), func(int) {})
+
+	assert.NoError(t, err)
+}
+
+func Test_ReduceCtx(t *testing.T) {
+	res, err := itrz.ReduceCtx(context.Background(), itrz.Of(1, 2, 3, 4), 0, sum)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, res)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := itrz.GenerateWithLast(0, func(n int) int { return n + 1 }).Peek(func(n int) {
+		if n == 4 {
+			cancel()
+		}
+	})
+
+	res, err = itrz.ReduceCtx(ctx, s, 0, sum)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 6, res)
+}
+
+func Test_Seq_ToSliceCtx(t *testing.T) {
+	res, err := itrz.Of(1, 2, 3).ToSliceCtx(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err = itrz.Of(1, 2, 3).ToSliceCtx(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, res)
+}
+
+func Test_Ctx_CancelledAfterLastElement(t *testing.T) {
+	tests := map[string]func(ctx context.Context, cancel context.CancelFunc) error{
+		"ForEachCtx": func(ctx context.Context, cancel context.CancelFunc) error {
+			return itrz.Of(1, 2, 3).ForEachCtx(ctx, func(n int) {
+				if n == 3 {
+					cancel()
+				}
+			})
+		},
+		"ReduceCtx": func(ctx context.Context, cancel context.CancelFunc) error {
+			res, err := itrz.ReduceCtx(ctx, itrz.Of(1, 2, 3), 0, func(n, acc int) int {
+				if n == 3 {
+					cancel()
+				}
+
+				return acc + n
+			})
+
+			assert.Equal(t, 6, res)
+
+			return err
+		},
+		"ToSliceCtx": func(ctx context.Context, cancel context.CancelFunc) error {
+			s := func(yield func(int) bool) {
+				_ = yield(1) && yield(2) && yield(3)
+				cancel()
+			}
+
+			res, err := itrz.Seq[int](s).ToSliceCtx(ctx)
+
+			assert.Equal(t, []int{1, 2, 3}, res)
+
+			return err
+		},
+	}
+
+	for name, run := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			assert.NoError(t, run(ctx, cancel))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package itrz
+
+import (
+	"sync"
+)
+
+// fanInResult is a value sent by one of the goroutines of a fanIn, or a panic raised by one of
+// them.
+type fanInResult[A any] struct {
+	idx      int
+	value    A
+	panicked any
+}
+
+// fanIn runs goroutines that send values to the goroutine ranging over a Seq. A panic raised by
+// one of the goroutines is re-raised by the consumer, and stopping the fanIn makes sure that every
+// goroutine has exited before the iteration returns.
+type fanIn[A any] struct {
+	done    chan struct{}
+	results chan fanInResult[A]
+	wg      sync.WaitGroup
+}
+
+// newFanIn creates a fanIn whose results channel has the specified buffer size.
+func newFanIn[A any](buffer int) *fanIn[A] {
+	return &fanIn[A]{
+		done:    make(chan struct{}),
+		results: make(chan fanInResult[A], buffer),
+	}
+}
+
+// spawn runs the function in a new goroutine, capturing any panic it raises so that it can be
+// re-raised by the consumer. The function must return once the done channel is closed.
+func (f *fanIn[A]) spawn(work func()) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				f.sendResult(fanInResult[A]{panicked: r})
+			}
+		}()
+
+		work()
+	}()
+}
+
+// send sends the value, tagged with the specified index, to the consumer. Returns false if the
+// consumer has stopped, in which case the calling goroutine must return.
+func (f *fanIn[A]) send(idx int, value A) bool {
+	return f.sendResult(fanInResult[A]{idx: idx, value: value})
+}
+
+func (f *fanIn[A]) sendResult(res fanInResult[A]) bool {
+	select {
+	case f.results <- res:
+		return true
+	case <-f.done:
+		return false
+	}
+}
+
+// closeWhenDone closes the results channel once every spawned goroutine has exited. It must be
+// called after the last goroutine has been spawned.
+func (f *fanIn[A]) closeWhenDone() {
+	go func() {
+		f.wg.Wait()
+		close(f.results)
+	}()
+}
+
+// received returns a Seq2 that yields the index and value of each result sent by the goroutines
+// until all of them have exited, re-raising any panic raised by one of them.
+func (f *fanIn[A]) received() Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		for res := range f.results {
+			if res.panicked != nil {
+				panic(res.panicked)
+			}
+
+			if !yield(res.idx, res.value) {
+				return
+			}
+		}
+	}
+}
+
+// stop signals the goroutines to return and waits for all of them to exit by draining the results
+// channel until it is closed. It must be deferred by the consumer once closeWhenDone is called.
+func (f *fanIn[A]) stop() {
+	close(f.done)
+	for range f.results {
+	}
+}
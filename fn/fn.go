@@ -19,3 +19,27 @@ type Function[A, B any] func(A) B
 // Function2 is a derived type that represents a function that accepts two arguments
 // of type A and B and produces a value of type C as output.
 type Function2[A, B, C any] func(A, B) C
+
+// PredicateE is a derived type that represents a fallible function that takes a value of
+// type A and returns a boolean or an error.
+type PredicateE[A any] func(A) (bool, error)
+
+// FunctionE is a derived type that represents a fallible function that takes a value of
+// type A as input and produces a value of type B or an error as output.
+type FunctionE[A, B any] func(A) (B, error)
+
+// Function2E is a derived type that represents a fallible function that accepts two arguments
+// of type A and B and produces a value of type C or an error as output.
+type Function2E[A, B, C any] func(A, B) (C, error)
+
+// Predicate2 is a derived type that represents a function that takes values of type A and
+// B and returns a boolean.
+type Predicate2[A, B any] func(A, B) bool
+
+// Consumer2 is a derived type that represents a function that takes values of type A and B
+// and has no return value.
+type Consumer2[A, B any] func(A, B)
+
+// Function3 is a derived type that represents a function that accepts three arguments of
+// type A, B and C and produces a value of type D as output.
+type Function3[A, B, C, D any] func(A, B, C) D
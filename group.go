@@ -0,0 +1,144 @@
+package itrz
+
+import (
+	"github.com/dustin10/itrz/fn"
+)
+
+// CountBy returns a map containing the number of elements yielded by the Seq for each key
+// produced by the specified fn.Function. Use CountBySeq to continue processing the result as a
+// Seq2.
+func CountBy[A any, K comparable](seq Seq[A], key fn.Function[A, K]) map[K]int {
+	counts := make(map[K]int)
+	for a := range seq {
+		counts[key(a)]++
+	}
+
+	return counts
+}
+
+// CountBySeq returns a Seq2 that yields each key produced by the specified fn.Function along with
+// the number of elements yielded by the Seq for that key. The Seq is consumed in full before the
+// first key is yielded and the keys are yielded in the order in which they were first produced.
+func CountBySeq[A any, K comparable](seq Seq[A], key fn.Function[A, K]) Seq2[K, int] {
+	return func(yield func(K, int) bool) {
+		keys := make([]K, 0)
+		counts := make(map[K]int)
+
+		for a := range seq {
+			k := key(a)
+			if _, exists := counts[k]; !exists {
+				keys = append(keys, k)
+			}
+
+			counts[k]++
+		}
+
+		for _, k := range keys {
+			if !yield(k, counts[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Frequencies returns a map containing the number of times each distinct element is yielded by
+// the Seq. Use FrequenciesSeq to continue processing the result as a Seq2.
+func Frequencies[A comparable](seq Seq[A]) map[A]int {
+	return CountBy(seq, func(a A) A { return a })
+}
+
+// FrequenciesSeq returns a Seq2 that yields each distinct element yielded by the Seq along with
+// the number of times it is yielded. The Seq is consumed in full before the first element is
+// yielded and the elements are yielded in the order in which they were first encountered.
+func FrequenciesSeq[A comparable](seq Seq[A]) Seq2[A, int] {
+	return CountBySeq(seq, func(a A) A { return a })
+}
+
+// GroupAdjacent returns a Seq2 that lazily groups consecutive elements of the Seq that produce
+// the same key from the specified fn.Function. Each group is yielded along with its key as soon
+// as an element with a different key is encountered, so only one group is held in memory at a
+// time. A key is yielded more than once if its elements are not adjacent, so the Seq should
+// already be ordered by key to group all elements sharing a key together.
+func GroupAdjacent[A any, K comparable](seq Seq[A], key fn.Function[A, K]) Seq2[K, []A] {
+	return func(yield func(K, []A) bool) {
+		var current K
+		var group []A
+
+		for a := range seq {
+			k := key(a)
+
+			if len(group) > 0 && k != current {
+				if !yield(current, group) {
+					return
+				}
+
+				group = nil
+			}
+
+			current = k
+			group = append(group, a)
+		}
+
+		if len(group) > 0 {
+			yield(current, group)
+		}
+	}
+}
+
+// GroupBy returns a map of the elements yielded by the Seq grouped by the key produced by the
+// specified fn.Function. The elements within each group retain the order in which they were
+// yielded. Use GroupBySeq to continue processing the result as a Seq2.
+func GroupBy[A any, K comparable](seq Seq[A], key fn.Function[A, K]) map[K][]A {
+	groups := make(map[K][]A)
+	for a := range seq {
+		k := key(a)
+		groups[k] = append(groups[k], a)
+	}
+
+	return groups
+}
+
+// GroupBySeq returns a Seq2 that yields each key produced by the specified fn.Function along with
+// the elements yielded by the Seq for that key, which retain the order in which they were
+// yielded. The Seq is consumed in full before the first group is yielded and the groups are
+// yielded in the order in which their keys were first produced. Use GroupAdjacent to group an
+// already ordered Seq lazily.
+func GroupBySeq[A any, K comparable](seq Seq[A], key fn.Function[A, K]) Seq2[K, []A] {
+	return func(yield func(K, []A) bool) {
+		keys := make([]K, 0)
+		groups := make(map[K][]A)
+
+		for a := range seq {
+			k := key(a)
+			if _, exists := groups[k]; !exists {
+				keys = append(keys, k)
+			}
+
+			groups[k] = append(groups[k], a)
+		}
+
+		for _, k := range keys {
+			if !yield(k, groups[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Partition splits the elements yielded by the Seq into two slices. The first slice contains
+// the elements that match the specified fn.Predicate and the second contains the elements that
+// do not. The elements within each slice retain the order in which they were yielded.
+func (s Seq[A]) Partition(p fn.Predicate[A]) ([]A, []A) {
+	matched := make([]A, 0)
+	unmatched := make([]A, 0)
+
+	for a := range s {
+		if p(a) {
+			matched = append(matched, a)
+		} else {
+			unmatched = append(unmatched, a)
+		}
+	}
+
+	return matched, unmatched
+}
@@ -0,0 +1,193 @@
+package itrz_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_CountBy(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		expected map[int]int
+	}{
+		"empty":     {values: []string{}, expected: map[int]int{}},
+		"nil":       {values: nil, expected: map[int]int{}},
+		"non-empty": {values: []string{"a", "bb", "c", "dd", "eee"}, expected: map[int]int{1: 2, 2: 2, 3: 1}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := itrz.CountBy(itrz.All(test.values), func(s string) int { return len(s) })
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func Test_CountBySeq(t *testing.T) {
+	tests := map[string]struct {
+		values []string
+		keys   []int
+		counts []int
+	}{
+		"empty":     {values: []string{}, keys: []int{}, counts: []int{}},
+		"nil":       {values: nil, keys: []int{}, counts: []int{}},
+		"non-empty": {values: []string{"bb", "a", "c", "dd", "eee"}, keys: []int{2, 1, 3}, counts: []int{2, 2, 1}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.CountBySeq(itrz.All(test.values), func(s string) int { return len(s) })
+
+			assert.Equal(t, test.keys, s.Keys().ToSlice())
+			assert.Equal(t, test.counts, s.Values().ToSlice())
+		})
+	}
+}
+
+func Test_Frequencies(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		expected map[string]int
+	}{
+		"empty":     {values: []string{}, expected: map[string]int{}},
+		"nil":       {values: nil, expected: map[string]int{}},
+		"non-empty": {values: []string{"a", "b", "a", "c", "a"}, expected: map[string]int{"a": 3, "b": 1, "c": 1}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Frequencies(itrz.All(test.values)))
+		})
+	}
+}
+
+func Test_FrequenciesSeq(t *testing.T) {
+	s := itrz.FrequenciesSeq(itrz.Of("b", "a", "b", "c", "a", "b"))
+
+	assert.Equal(t, []string{"b", "a", "c"}, s.Keys().ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, s.Values().ToSlice())
+	assert.Equal(t, []string{"b"}, s.Limit(1).Keys().ToSlice())
+}
+
+func Test_GroupAdjacent(t *testing.T) {
+	type group struct {
+		key    string
+		values []string
+	}
+
+	tests := map[string]struct {
+		values   []string
+		expected []group
+	}{
+		"empty":    {values: []string{}, expected: []group{}},
+		"nil":      {values: nil, expected: []group{}},
+		"one":      {values: []string{"apple"}, expected: []group{{"a", []string{"apple"}}}},
+		"sorted":   {values: []string{"apple", "avocado", "banana", "cherry", "coconut"}, expected: []group{{"a", []string{"apple", "avocado"}}, {"b", []string{"banana"}}, {"c", []string{"cherry", "coconut"}}}},
+		"unsorted": {values: []string{"apple", "banana", "avocado"}, expected: []group{{"a", []string{"apple"}}, {"b", []string{"banana"}}, {"a", []string{"avocado"}}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := make([]group, 0)
+			for k, vs := range itrz.GroupAdjacent(itrz.All(test.values), firstLetter) {
+				res = append(res, group{k, vs})
+			}
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func Test_GroupAdjacent_Lazy(t *testing.T) {
+	pulled := 0
+	source := itrz.GenerateWithLast(0, func(n int) int { return n + 1 }).Peek(func(int) { pulled = pulled + 1 })
+
+	keys := make([]int, 0)
+	for k := range itrz.GroupAdjacent(source, func(n int) int { return n / 3 }) {
+		keys = append(keys, k)
+		if len(keys) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{0, 1}, keys)
+	assert.Equal(t, 6, pulled)
+}
+
+func Test_GroupBy(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		expected map[string][]string
+	}{
+		"empty":     {values: []string{}, expected: map[string][]string{}},
+		"nil":       {values: nil, expected: map[string][]string{}},
+		"non-empty": {values: []string{"apple", "banana", "avocado"}, expected: map[string][]string{"a": {"apple", "avocado"}, "b": {"banana"}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.GroupBy(itrz.All(test.values), firstLetter))
+		})
+	}
+}
+
+func Test_GroupBy_Seq2(t *testing.T) {
+	groups := itrz.GroupBy(itrz.Of("apple", "banana", "avocado"), firstLetter)
+
+	sizes := itrz.Map2(itrz.All2(groups), func(k string, vs []string) int { return len(vs) })
+
+	assert.Equal(t, 3, itrz.Reduce(sizes, 0, sum))
+}
+
+func Test_GroupBySeq(t *testing.T) {
+	tests := map[string]struct {
+		values []string
+		keys   []string
+		groups [][]string
+	}{
+		"empty":     {values: []string{}, keys: []string{}, groups: [][]string{}},
+		"nil":       {values: nil, keys: []string{}, groups: [][]string{}},
+		"non-empty": {values: []string{"banana", "apple", "blueberry", "avocado"}, keys: []string{"b", "a"}, groups: [][]string{{"banana", "blueberry"}, {"apple", "avocado"}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.GroupBySeq(itrz.All(test.values), firstLetter)
+
+			assert.Equal(t, test.keys, s.Keys().ToSlice())
+			assert.Equal(t, test.groups, s.Values().ToSlice())
+		})
+	}
+}
+
+func Test_Seq_Partition(t *testing.T) {
+	tests := map[string]struct {
+		values    []int
+		matched   []int
+		unmatched []int
+	}{
+		"empty":     {values: []int{}, matched: []int{}, unmatched: []int{}},
+		"nil":       {values: nil, matched: []int{}, unmatched: []int{}},
+		"all odd":   {values: []int{1, 3}, matched: []int{1, 3}, unmatched: []int{}},
+		"all even":  {values: []int{2, 4}, matched: []int{}, unmatched: []int{2, 4}},
+		"non-empty": {values: []int{1, 2, 3, 4, 5}, matched: []int{1, 3, 5}, unmatched: []int{2, 4}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matched, unmatched := itrz.All(test.values).Partition(isOdd)
+
+			assert.Equal(t, test.matched, matched)
+			assert.Equal(t, test.unmatched, unmatched)
+		})
+	}
+}
+
+func firstLetter(s string) string {
+	return strings.ToLower(s[:1])
+}
@@ -0,0 +1,41 @@
+// Package text converts arbitrary values to and from a textual representation. It is used to
+// implement encoding.TextMarshaler and encoding.TextUnmarshaler for the generic container types
+// of this module.
+package text
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+// Marshal returns the textual representation of the value. A value that implements
+// encoding.TextMarshaler is converted using it and a value whose type is derived from string is
+// converted as is. Any other value is converted to JSON, which is the natural textual
+// representation of numbers and booleans.
+func Marshal(v any) ([]byte, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return []byte(rv.String()), nil
+	}
+
+	return json.Marshal(v)
+}
+
+// Unmarshal parses the textual representation of a value, as produced by Marshal, and stores the
+// result in the value pointed to by v.
+func Unmarshal(data []byte, v any) error {
+	if u, ok := v.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(data)
+	}
+
+	if rv := reflect.ValueOf(v).Elem(); rv.Kind() == reflect.String {
+		rv.SetString(string(data))
+		return nil
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,47 @@
+package text_test
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/internal/text"
+)
+
+type status string
+
+func Test_RoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		value  any
+		text   string
+		target func() any
+	}{
+		"string":         {value: "a b", text: "a b", target: func() any { return new(string) }},
+		"derived string": {value: status("active"), text: "active", target: func() any { return new(status) }},
+		"int":            {value: 42, text: "42", target: func() any { return new(int) }},
+		"float":          {value: 1.5, text: "1.5", target: func() any { return new(float64) }},
+		"bool":           {value: true, text: "true", target: func() any { return new(bool) }},
+		"TextMarshaler":  {value: netip.MustParseAddr("10.0.0.1"), text: "10.0.0.1", target: func() any { return new(netip.Addr) }},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := text.Marshal(test.value)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.text, string(data))
+
+			target := test.target()
+
+			assert.Nil(t, text.Unmarshal(data, target))
+			assert.Equal(t, test.value, reflect.ValueOf(target).Elem().Interface())
+		})
+	}
+}
+
+func Test_Unmarshal_Invalid(t *testing.T) {
+	assert.Error(t, text.Unmarshal([]byte("x"), new(int)))
+	assert.Error(t, text.Unmarshal([]byte("x"), new(netip.Addr)))
+}
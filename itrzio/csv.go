@@ -0,0 +1,337 @@
+package itrzio
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/dustin10/itrz"
+)
+
+// csvTag defines the struct tag used to map the columns of a CSV header to the fields of a struct.
+const csvTag = "csv"
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// CSVRecords returns an itrz.SeqE that yields each record read from the CSV encoded io.Reader as
+// a slice of fields. The error yielded when a record cannot be parsed wraps a *csv.ParseError,
+// which carries the line number of the record.
+func CSVRecords(r io.Reader, opts ...Option) itrz.SeqE[[]string] {
+	return readCSV(r, newConfig(opts), func(_ *csv.Reader, record []string) ([]string, bool, error) {
+		return record, true, nil
+	})
+}
+
+// CSVStructs returns an itrz.SeqE that yields a value of type T for each record read from the
+// CSV encoded io.Reader. The first record is the header, whose columns are mapped to the exported
+// fields of T by the name in their csv struct tag, or by the name of the field if it has no tag.
+// Fields tagged with "-" are ignored, as are columns that have no matching field. Fields of
+// string, bool, integer and floating point types are supported, as are fields whose type
+// implements encoding.TextUnmarshaler. Errors carry the line number of the offending record.
+// Panics if T is not a struct type.
+func CSVStructs[T any](r io.Reader, opts ...Option) itrz.SeqE[T] {
+	fields := csvFieldsFor[T]()
+	config := newConfig(opts)
+
+	return func(yield func(T, error) bool) {
+		var columns []int
+
+		decode := func(cr *csv.Reader, record []string) (T, bool, error) {
+			var t T
+
+			if columns == nil {
+				columns = fields.columns(record)
+				return t, false, nil
+			}
+
+			err := fields.decode(reflect.ValueOf(&t).Elem(), columns, record, cr)
+
+			return t, err == nil, err
+		}
+
+		readCSV(r, config, decode)(yield)
+	}
+}
+
+// WriteCSVRecords writes each record yielded by the itrz.Seq to the io.Writer as CSV. Errors carry
+// the line number of the record that could not be written.
+func WriteCSVRecords(w io.Writer, seq itrz.Seq[[]string], opts ...Option) error {
+	return writeCSV(w, newConfig(opts), nil, seq, func(record []string) ([]string, error) {
+		return record, nil
+	})
+}
+
+// WriteCSVStructs writes each value yielded by the itrz.Seq to the io.Writer as a CSV record,
+// preceded by a header. The header and fields are determined in the same way as for CSVStructs,
+// and fields whose type implements encoding.TextMarshaler are written using it. Errors carry the
+// line number of the record that could not be written. Panics if T is not a struct type.
+func WriteCSVStructs[T any](w io.Writer, seq itrz.Seq[T], opts ...Option) error {
+	fields := csvFieldsFor[T]()
+
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+
+	return writeCSV(w, newConfig(opts), header, seq, func(t T) ([]string, error) {
+		v := reflect.New(reflect.TypeFor[T]()).Elem()
+		v.Set(reflect.ValueOf(t))
+
+		return fields.encode(v)
+	})
+}
+
+// readCSV returns an itrz.SeqE that reads each record from the CSV encoded io.Reader and yields
+// the result of decoding it, unless the decode function reports that it should be skipped.
+func readCSV[A any](r io.Reader, config Config, decode func(cr *csv.Reader, record []string) (A, bool, error)) itrz.SeqE[A] {
+	return func(yield func(A, error) bool) {
+		finished := false
+		defer func() {
+			if !finished {
+				_ = closeReader(r, config)
+			}
+		}()
+
+		cr := csv.NewReader(r)
+		cr.Comma = config.Comma
+		cr.Comment = config.Comment
+
+		var err error
+		for {
+			record, readErr := cr.Read()
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+
+			if readErr != nil {
+				err = fmt.Errorf("read CSV record: %w", readErr)
+				break
+			}
+
+			a, ok, decodeErr := decode(cr, record)
+			if decodeErr != nil {
+				err = decodeErr
+				break
+			}
+
+			if ok && !yield(a, nil) {
+				return
+			}
+		}
+
+		finished = true
+		finish(yield, r, config, err)
+	}
+}
+
+// writeCSV writes the header, if there is one, followed by the encoded form of each element
+// yielded by the itrz.Seq to the io.Writer as CSV.
+func writeCSV[A any](w io.Writer, config Config, header []string, seq itrz.Seq[A], encode func(A) ([]string, error)) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = config.Comma
+
+	line := 1
+
+	if header != nil {
+		if err := cw.Write(header); err != nil {
+			return fmt.Errorf("write CSV header: %w", err)
+		}
+
+		line = line + 1
+	}
+
+	for a := range seq {
+		record, err := encode(a)
+		if err != nil {
+			return fmt.Errorf("encode CSV record on line %d: %w", line, err)
+		}
+
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("write CSV record on line %d: %w", line, err)
+		}
+
+		line = line + 1
+	}
+
+	cw.Flush()
+
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("write CSV records: %w", err)
+	}
+
+	return nil
+}
+
+// csvField is a field of a struct that is mapped to a CSV column.
+type csvField struct {
+	name  string
+	index int
+}
+
+// csvFields are the fields of a struct that are mapped to CSV columns in declaration order.
+type csvFields []csvField
+
+// csvFieldsFor returns the csvFields of T. Panics if T is not a struct type.
+func csvFieldsFor[T any]() csvFields {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("%s is not a struct type", t))
+	}
+
+	fields := make(csvFields, 0, t.NumField())
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := f.Name
+		if tag, ok := f.Tag.Lookup(csvTag); ok {
+			if tag == "-" {
+				continue
+			}
+
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		fields = append(fields, csvField{name: name, index: i})
+	}
+
+	return fields
+}
+
+// columns maps each column of the header to the index of the struct field it should be decoded
+// into, or -1 if there is no matching field.
+func (fs csvFields) columns(header []string) []int {
+	columns := make([]int, len(header))
+
+	for i, name := range header {
+		columns[i] = -1
+
+		for _, f := range fs {
+			if f.name == name {
+				columns[i] = f.index
+				break
+			}
+		}
+	}
+
+	return columns
+}
+
+// decode sets the fields of the struct value from the record using the columns of the header.
+// The csv.Reader that read the record is used to report the position of a field that could not
+// be decoded.
+func (fs csvFields) decode(v reflect.Value, columns []int, record []string, cr *csv.Reader) error {
+	for i, s := range record {
+		if i >= len(columns) || columns[i] < 0 {
+			continue
+		}
+
+		if err := decodeCSVField(v.Field(columns[i]), s); err != nil {
+			line, column := cr.FieldPos(i)
+			name := v.Type().Field(columns[i]).Name
+
+			return fmt.Errorf("decode CSV field %s on line %d, column %d: %w", name, line, column, err)
+		}
+	}
+
+	return nil
+}
+
+// encode returns the fields of the addressable struct value as a CSV record.
+func (fs csvFields) encode(v reflect.Value) ([]string, error) {
+	record := make([]string, len(fs))
+
+	for i, f := range fs {
+		s, err := encodeCSVField(v.Field(f.index))
+		if err != nil {
+			return nil, fmt.Errorf("encode CSV field %s: %w", v.Type().Field(f.index).Name, err)
+		}
+
+		record[i] = s
+	}
+
+	return record, nil
+}
+
+// decodeCSVField sets the field value by parsing the string.
+func decodeCSVField(v reflect.Value, s string) error {
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// encodeCSVField formats the addressable field value as a string.
+func encodeCSVField(v reflect.Value) (string, error) {
+	if v.Addr().Type().Implements(textMarshalerType) {
+		text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", err
+		}
+
+		return string(text), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
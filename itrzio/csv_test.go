@@ -0,0 +1,148 @@
+package itrzio_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/itrzio"
+)
+
+type person struct {
+	Name    string    `csv:"name"`
+	Age     int       `csv:"age"`
+	Height  float64   `csv:"height"`
+	Active  bool      `csv:"active"`
+	Born    time.Time `csv:"born"`
+	Ignored string    `csv:"-"`
+	Nick    string
+	private string
+}
+
+func Test_CSVRecords(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		opts   []itrzio.Option
+		expect [][]string
+	}{
+		"empty":         {input: "", expect: [][]string{}},
+		"records":       {input: "a,b\nc,d\n", expect: [][]string{{"a", "b"}, {"c", "d"}}},
+		"quoted fields": {input: "\"a,b\",\"c\nd\"\n", expect: [][]string{{"a,b", "c\nd"}}},
+		"comma":         {input: "a;b\nc;d", opts: []itrzio.Option{itrzio.WithComma(';')}, expect: [][]string{{"a", "b"}, {"c", "d"}}},
+		"comment":       {input: "# header\na,b", opts: []itrzio.Option{itrzio.WithComment('#')}, expect: [][]string{{"a", "b"}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrzio.CSVRecords(strings.NewReader(test.input), test.opts...).ToSliceE()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_CSVRecords_ParseError(t *testing.T) {
+	res, err := itrzio.CSVRecords(strings.NewReader("a,b\nc,d\ne\n")).ToSliceE()
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}}, res)
+
+	var parseErr *csv.ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, 3, parseErr.Line)
+		assert.ErrorIs(t, err, csv.ErrFieldCount)
+	}
+}
+
+func Test_CSVStructs(t *testing.T) {
+	input := "Nick,age,name,unknown,active,height,born\n" +
+		"bob,42,Robert,x,true,1.85,2000-01-02T00:00:00Z\n" +
+		",7,Alice,y,false,1.2,2010-03-04T00:00:00Z\n"
+
+	res, err := itrzio.CSVStructs[person](strings.NewReader(input)).ToSliceE()
+
+	expect := []person{
+		{Name: "Robert", Age: 42, Height: 1.85, Active: true, Born: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Nick: "bob"},
+		{Name: "Alice", Age: 7, Height: 1.2, Born: time.Date(2010, 3, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, expect, res)
+}
+
+func Test_CSVStructs_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		expect int
+		err    string
+	}{
+		"invalid int":   {input: "name,age\na,1\nb,x\n", expect: 1, err: `decode CSV field Age on line 3, column 3: strconv.ParseInt: parsing "x": invalid syntax`},
+		"invalid bool":  {input: "name,active\na,yes\n", err: `decode CSV field Active on line 2, column 3: strconv.ParseBool: parsing "yes": invalid syntax`},
+		"invalid text":  {input: "born\nyesterday\n", err: `decode CSV field Born on line 2, column 1: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`},
+		"parse failure": {input: "name,age\na,1\nb\n", expect: 1, err: "read CSV record: record on line 3: wrong number of fields"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrzio.CSVStructs[person](strings.NewReader(test.input)).ToSliceE()
+
+			assert.Len(t, res, test.expect)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+
+	assert.Panics(t, func() { itrzio.CSVStructs[int](strings.NewReader("")) })
+}
+
+func Test_WriteCSVRecords(t *testing.T) {
+	var sb strings.Builder
+
+	err := itrzio.WriteCSVRecords(&sb, itrz.Of([]string{"a", "b"}, []string{"c,d", "e"}), itrzio.WithComma(','))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "a,b\n\"c,d\",e\n", sb.String())
+
+	err = itrzio.WriteCSVRecords(&sb, itrz.Of([]string{"a"}), itrzio.WithComma('"'))
+
+	assert.ErrorContains(t, err, "write CSV record on line 1:")
+}
+
+func Test_WriteCSVStructs(t *testing.T) {
+	people := itrz.Of(
+		person{Name: "Robert", Age: 42, Height: 1.85, Active: true, Born: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Ignored: "x", Nick: "bob"},
+		person{Name: "Alice", Age: 7, Height: 1.2, Born: time.Date(2010, 3, 4, 0, 0, 0, 0, time.UTC)},
+	)
+
+	var sb strings.Builder
+
+	err := itrzio.WriteCSVStructs(&sb, people)
+
+	expect := "name,age,height,active,born,Nick\n" +
+		"Robert,42,1.85,true,2000-01-02T00:00:00Z,bob\n" +
+		"Alice,7,1.2,false,2010-03-04T00:00:00Z,\n"
+
+	assert.Nil(t, err)
+	assert.Equal(t, expect, sb.String())
+
+	res, err := itrzio.CSVStructs[person](strings.NewReader(sb.String())).ToSliceE()
+
+	assert.Nil(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "bob", res[0].Nick)
+	assert.Empty(t, res[0].Ignored)
+}
+
+func Test_WriteCSVStructs_Error(t *testing.T) {
+	invalid := person{Born: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	var sb strings.Builder
+
+	err := itrzio.WriteCSVStructs(&sb, itrz.Of(person{}, invalid))
+
+	assert.ErrorContains(t, err, "encode CSV record on line 3: encode CSV field Born:")
+}
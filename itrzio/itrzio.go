@@ -0,0 +1,213 @@
+// Package itrzio provides sequences that read their elements from an io.Reader, and functions that
+// write the elements of a sequence to an io.Writer.
+//
+// The sequences returned by this package consume the io.Reader as they are iterated, so ranging
+// over one of them more than once continues reading from wherever the previous iteration stopped.
+package itrzio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dustin10/itrz"
+)
+
+// initialBufferSize defines the size of the buffer initially allocated for scanning tokens. The
+// buffer grows as needed up to the configured maximum token size.
+const initialBufferSize = 4096
+
+// Option defines a function that can be used to customize the configuration used to read from
+// an io.Reader.
+type Option func(config *Config)
+
+// Config contains the supported configuration for reading from an io.Reader.
+type Config struct {
+	// MaxTokenSize defines the maximum size in bytes of a single token that can be scanned. A
+	// token larger than this causes the sequence to yield an error that wraps bufio.ErrTooLong.
+	// Defaults to bufio.MaxScanTokenSize. It is ignored by Bytes.
+	MaxTokenSize int
+	// Close defines whether the io.Reader is closed once iteration stops, whether because it was
+	// exhausted, it failed or the consumer stopped early. It only has an effect if the io.Reader
+	// implements io.Closer.
+	Close bool
+	// Comma defines the field delimiter used to read and write CSV. Defaults to ','.
+	Comma rune
+	// Comment defines the character that starts a comment line when reading CSV. Comment lines
+	// are skipped. Defaults to none.
+	Comment rune
+}
+
+// WithComma is an Option that can be used to configure the field delimiter used to read and
+// write CSV.
+func WithComma(r rune) Option {
+	return func(config *Config) {
+		config.Comma = r
+	}
+}
+
+// WithComment is an Option that can be used to configure the character that starts a comment
+// line when reading CSV.
+func WithComment(r rune) Option {
+	return func(config *Config) {
+		config.Comment = r
+	}
+}
+
+// WithMaxTokenSize is an Option that can be used to configure the maximum size in bytes of a
+// single token that can be scanned.
+func WithMaxTokenSize(n int) Option {
+	return func(config *Config) {
+		config.MaxTokenSize = n
+	}
+}
+
+// WithClose is an Option that can be used to close the io.Reader once iteration stops.
+func WithClose() Option {
+	return func(config *Config) {
+		config.Close = true
+	}
+}
+
+// Bytes returns an itrz.SeqE that yields the contents of the io.Reader in chunks of the specified
+// size. Every chunk but the last one is exactly chunkSize bytes long. Each yielded chunk is a
+// newly allocated slice, so it may be retained by the consumer. Panics if chunkSize is not
+// positive.
+func Bytes(r io.Reader, chunkSize int, opts ...Option) itrz.SeqE[[]byte] {
+	if chunkSize <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	config := newConfig(opts)
+
+	return func(yield func([]byte, error) bool) {
+		finished := false
+		defer func() {
+			if !finished {
+				_ = closeReader(r, config)
+			}
+		}()
+
+		var err error
+		for idx := 1; ; idx++ {
+			chunk := make([]byte, chunkSize)
+
+			n, readErr := io.ReadFull(r, chunk)
+			if n > 0 && !yield(chunk[:n], nil) {
+				return
+			}
+
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				break
+			}
+
+			if readErr != nil {
+				err = fmt.Errorf("read chunk %d: %w", idx, readErr)
+				break
+			}
+		}
+
+		finished = true
+		finish(yield, r, config, err)
+	}
+}
+
+// Lines returns an itrz.SeqE that yields each line of text read from the io.Reader, stripped of
+// any trailing end-of-line marker. The last line is yielded even if it has no end-of-line marker.
+func Lines(r io.Reader, opts ...Option) itrz.SeqE[string] {
+	return ScanWith(r, bufio.ScanLines, opts...)
+}
+
+// Words returns an itrz.SeqE that yields each space-separated word of text read from the
+// io.Reader. Space is defined by unicode.IsSpace.
+func Words(r io.Reader, opts ...Option) itrz.SeqE[string] {
+	return ScanWith(r, bufio.ScanWords, opts...)
+}
+
+// ScanWith returns an itrz.SeqE that yields each token read from the io.Reader using a
+// bufio.Scanner configured with the specified bufio.SplitFunc. If the bufio.Scanner fails, e.g.
+// because a token is larger than the configured maximum token size, then the error is yielded
+// along with the number of the token that could not be scanned. Panics if the configured maximum
+// token size is not positive.
+func ScanWith(r io.Reader, split bufio.SplitFunc, opts ...Option) itrz.SeqE[string] {
+	config := newConfig(opts)
+
+	if config.MaxTokenSize <= 0 {
+		panic("max token size must be positive")
+	}
+
+	return func(yield func(string, error) bool) {
+		finished := false
+		defer func() {
+			if !finished {
+				_ = closeReader(r, config)
+			}
+		}()
+
+		scanner := bufio.NewScanner(r)
+		scanner.Split(split)
+		scanner.Buffer(make([]byte, 0, min(initialBufferSize, config.MaxTokenSize)), config.MaxTokenSize)
+
+		idx := 1
+		for ; scanner.Scan(); idx++ {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+
+		var err error
+		if scanErr := scanner.Err(); scanErr != nil {
+			err = fmt.Errorf("scan token %d: %w", idx, scanErr)
+		}
+
+		finished = true
+		finish(yield, r, config, err)
+	}
+}
+
+// newConfig creates the Config for reading from an io.Reader, applying the specified Options.
+func newConfig(opts []Option) Config {
+	config := Config{
+		MaxTokenSize: bufio.MaxScanTokenSize,
+		Comma:        ',',
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// finish closes the io.Reader if configured to do so once a sequence has stopped without the
+// consumer stopping it early, and yields the error that caused the sequence to stop, if any. If
+// the sequence did not fail then any error returned by closing the io.Reader is yielded instead.
+func finish[A any](yield func(A, error) bool, r io.Reader, config Config, err error) {
+	if closeErr := closeReader(r, config); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		var zero A
+		yield(zero, err)
+	}
+}
+
+// closeReader closes the io.Reader if configured to do so and it implements io.Closer.
+func closeReader(r io.Reader, config Config) error {
+	if !config.Close {
+		return nil
+	}
+
+	c, ok := r.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	if err := c.Close(); err != nil {
+		return fmt.Errorf("close reader: %w", err)
+	}
+
+	return nil
+}
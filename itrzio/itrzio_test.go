@@ -0,0 +1,194 @@
+package itrzio_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/itrzio"
+)
+
+var errTest = errors.New("test error")
+
+// closer is an io.ReadCloser that records how many times it was closed.
+type closer struct {
+	io.Reader
+	closed int
+	err    error
+}
+
+func (c *closer) Close() error {
+	c.closed = c.closed + 1
+	return c.err
+}
+
+func Test_Lines(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		expect []string
+	}{
+		"empty":                {input: "", expect: []string{}},
+		"single line":          {input: "one", expect: []string{"one"}},
+		"trailing newline":     {input: "one\ntwo\n", expect: []string{"one", "two"}},
+		"no trailing newline":  {input: "one\ntwo", expect: []string{"one", "two"}},
+		"carriage returns":     {input: "one\r\ntwo\r\n", expect: []string{"one", "two"}},
+		"blank lines retained": {input: "one\n\ntwo", expect: []string{"one", "", "two"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrzio.Lines(strings.NewReader(test.input)).ToSliceE()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_Words(t *testing.T) {
+	res, err := itrzio.Words(strings.NewReader("  the quick\tbrown\n\nfox ")).ToSliceE()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"the", "quick", "brown", "fox"}, res)
+}
+
+func Test_ScanWith(t *testing.T) {
+	res, err := itrzio.ScanWith(strings.NewReader("abc"), bufio.ScanRunes).ToSliceE()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, res)
+}
+
+func Test_ScanWith_MaxTokenSize(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		expect []string
+		err    string
+	}{
+		"tokens within limit": {input: "abcd\nefgh", expect: []string{"abcd", "efgh"}},
+		"token too long":      {input: "ab\ncd\nefghijkl\nmn", expect: []string{"ab", "cd"}, err: "scan token 3: bufio.Scanner: token too long"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrzio.Lines(strings.NewReader(test.input), itrzio.WithMaxTokenSize(5)).ToSliceE()
+
+			assert.Equal(t, test.expect, res)
+
+			if test.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, bufio.ErrTooLong)
+			}
+		})
+	}
+
+	assert.Panics(t, func() { itrzio.Lines(strings.NewReader(""), itrzio.WithMaxTokenSize(0)) })
+}
+
+func Test_ScanWith_ReadError(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("one\ntwo\n"), iotest.ErrReader(errTest))
+
+	res, err := itrzio.Lines(r).ToSliceE()
+
+	assert.Equal(t, []string{"one", "two"}, res)
+	assert.ErrorIs(t, err, errTest)
+}
+
+func Test_Bytes(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		chunkSize int
+		expect    []string
+	}{
+		"empty":         {input: "", chunkSize: 2, expect: []string{}},
+		"exact chunks":  {input: "abcdef", chunkSize: 2, expect: []string{"ab", "cd", "ef"}},
+		"partial chunk": {input: "abcdefg", chunkSize: 3, expect: []string{"abc", "def", "g"}},
+		"one chunk":     {input: "abc", chunkSize: 8, expect: []string{"abc"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// OneByteReader ensures that short reads are assembled into full chunks.
+			res, err := itrzio.Bytes(iotest.OneByteReader(strings.NewReader(test.input)), test.chunkSize).ToSliceE()
+
+			assert.Nil(t, err)
+
+			chunks := make([]string, 0, len(res))
+			for _, bs := range res {
+				chunks = append(chunks, string(bs))
+			}
+
+			assert.Equal(t, test.expect, chunks)
+		})
+	}
+
+	assert.Panics(t, func() { itrzio.Bytes(strings.NewReader(""), 0) })
+}
+
+func Test_Bytes_ReadError(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("abcd"), iotest.ErrReader(errTest))
+
+	res, err := itrzio.Bytes(r, 2).ToSliceE()
+
+	assert.Equal(t, [][]byte{[]byte("ab"), []byte("cd")}, res)
+	assert.EqualError(t, err, "read chunk 3: test error")
+	assert.ErrorIs(t, err, errTest)
+}
+
+func Test_Close(t *testing.T) {
+	tests := map[string]struct {
+		opts   []itrzio.Option
+		limit  int
+		expect int
+	}{
+		"not closed by default":          {limit: 10, expect: 0},
+		"closed when exhausted":          {opts: []itrzio.Option{itrzio.WithClose()}, limit: 10, expect: 1},
+		"closed when stopped early":      {opts: []itrzio.Option{itrzio.WithClose()}, limit: 1, expect: 1},
+		"closed when stopped at first":   {opts: []itrzio.Option{itrzio.WithClose()}, limit: 0, expect: 1},
+		"not closed when stopped early":  {limit: 1, expect: 0},
+		"closed when stopped at the end": {opts: []itrzio.Option{itrzio.WithClose()}, limit: 3, expect: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &closer{Reader: strings.NewReader("one\ntwo\nthree")}
+
+			count := 0
+			for _, err := range itrzio.Lines(c, test.opts...) {
+				assert.Nil(t, err)
+
+				if count == test.limit {
+					break
+				}
+
+				count = count + 1
+			}
+
+			assert.Equal(t, test.expect, c.closed)
+		})
+	}
+}
+
+func Test_Close_Error(t *testing.T) {
+	c := &closer{Reader: strings.NewReader("one\ntwo"), err: errTest}
+
+	res, err := itrzio.Lines(c, itrzio.WithClose()).ToSliceE()
+
+	assert.Equal(t, []string{"one", "two"}, res)
+	assert.EqualError(t, err, "close reader: test error")
+	assert.Equal(t, 1, c.closed)
+
+	c = &closer{Reader: strings.NewReader("abc"), err: errTest}
+
+	_, err = itrzio.Bytes(c, 2, itrzio.WithClose()).ToSliceE()
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, 1, c.closed)
+}
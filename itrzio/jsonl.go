@@ -0,0 +1,122 @@
+package itrzio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dustin10/itrz"
+)
+
+// JSONLines returns an itrz.SeqE that yields a value of type T for each JSON value read from the
+// newline delimited JSON encoded io.Reader. The values are decoded using a json.Decoder, so a value
+// may span multiple lines. Errors carry the line number of the value that could not be decoded.
+func JSONLines[T any](r io.Reader, opts ...Option) itrz.SeqE[T] {
+	config := newConfig(opts)
+
+	return func(yield func(T, error) bool) {
+		finished := false
+		defer func() {
+			if !finished {
+				_ = closeReader(r, config)
+			}
+		}()
+
+		lc := &lineCounter{r: r}
+		dec := json.NewDecoder(lc)
+
+		var err error
+		for {
+			var t T
+
+			decodeErr := dec.Decode(&t)
+			if errors.Is(decodeErr, io.EOF) {
+				break
+			}
+
+			if decodeErr != nil {
+				err = fmt.Errorf("decode JSON on line %d: %w", lc.lineAt(errorOffset(dec, lc, decodeErr)), decodeErr)
+				break
+			}
+
+			lc.lineAt(dec.InputOffset())
+
+			if !yield(t, nil) {
+				return
+			}
+		}
+
+		finished = true
+		finish(yield, r, config, err)
+	}
+}
+
+// WriteJSONLines writes each value yielded by the itrz.Seq to the io.Writer as JSON followed by a
+// newline. Errors carry the line number of the value that could not be written.
+func WriteJSONLines[T any](w io.Writer, seq itrz.Seq[T]) error {
+	enc := json.NewEncoder(w)
+
+	line := 1
+	for t := range seq {
+		if err := enc.Encode(t); err != nil {
+			return fmt.Errorf("encode JSON on line %d: %w", line, err)
+		}
+
+		line = line + 1
+	}
+
+	return nil
+}
+
+// errorOffset returns the offset in the input at which the json.Decoder encountered the error.
+// A json.SyntaxError carries the offset. If the input ended part way through a value then the
+// offset of the end of the input is returned. Otherwise, the value that could not be decoded has
+// been consumed, so the offset of its end is returned.
+func errorOffset(dec *json.Decoder, lc *lineCounter, err error) int64 {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return syntaxErr.Offset
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return lc.offset
+	}
+
+	return dec.InputOffset()
+}
+
+// lineCounter is an io.Reader that records the offsets of the newlines read from the wrapped
+// io.Reader so that an offset in the input can be converted to a line number.
+type lineCounter struct {
+	r        io.Reader
+	offset   int64
+	newlines []int64
+	line     int
+}
+
+func (lc *lineCounter) Read(p []byte) (int, error) {
+	n, err := lc.r.Read(p)
+
+	for i, b := range p[:n] {
+		if b == '\n' {
+			lc.newlines = append(lc.newlines, lc.offset+int64(i))
+		}
+	}
+
+	lc.offset = lc.offset + int64(n)
+
+	return n, err
+}
+
+// lineAt returns the line number of the byte preceding the specified offset and discards the
+// newlines before it, so that memory use does not grow with the size of the input. The offsets
+// passed to successive calls must not decrease.
+func (lc *lineCounter) lineAt(offset int64) int {
+	for len(lc.newlines) > 0 && lc.newlines[0] < offset-1 {
+		lc.line = lc.line + 1
+		lc.newlines = lc.newlines[1:]
+	}
+
+	return lc.line + 1
+}
@@ -0,0 +1,97 @@
+package itrzio_test
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/itrzio"
+)
+
+type event struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func Test_JSONLines(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		expect []event
+	}{
+		"empty":               {input: "", expect: []event{}},
+		"values":              {input: "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n", expect: []event{{1, "a"}, {2, "b"}}},
+		"no trailing newline": {input: "{\"id\":1}\n{\"id\":2}", expect: []event{{ID: 1}, {ID: 2}}},
+		"blank lines":         {input: "\n{\"id\":1}\n\n{\"id\":2}\n\n", expect: []event{{ID: 1}, {ID: 2}}},
+		"multi-line value":    {input: "{\n\"id\": 1\n}\n{\"id\":2}", expect: []event{{ID: 1}, {ID: 2}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// OneByteReader ensures that line numbers are tracked across reads.
+			res, err := itrzio.JSONLines[event](iotest.OneByteReader(strings.NewReader(test.input))).ToSliceE()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_JSONLines_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		expect int
+		err    string
+	}{
+		"syntax error on first line": {input: "{bad}\n", err: "decode JSON on line 1: invalid character 'b' looking for beginning of object key string"},
+		"syntax error":               {input: "{\"id\":1}\n{\"id\":2}\n\n{bad}\n{\"id\":3}\n", expect: 2, err: "decode JSON on line 4: invalid character 'b' looking for beginning of object key string"},
+		"type error":                 {input: "{\"id\":1}\n{\"id\":\"x\"}\n", expect: 1, err: "decode JSON on line 2: json: cannot unmarshal string into Go struct field event.id of type int"},
+		"truncated value":            {input: "{\"id\":1}\n{\"id\":", expect: 1, err: "decode JSON on line 2: unexpected EOF"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrzio.JSONLines[event](strings.NewReader(test.input)).ToSliceE()
+
+			assert.Len(t, res, test.expect)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func Test_JSONLines_Close(t *testing.T) {
+	c := &closer{Reader: strings.NewReader("{\"id\":1}\n{\"id\":2}\n")}
+
+	for range itrzio.JSONLines[event](c, itrzio.WithClose()) {
+		break
+	}
+
+	assert.Equal(t, 1, c.closed)
+}
+
+func Test_WriteJSONLines(t *testing.T) {
+	var sb strings.Builder
+
+	err := itrzio.WriteJSONLines(&sb, itrz.Of(event{1, "a"}, event{2, "b"}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n", sb.String())
+
+	res, err := itrzio.JSONLines[event](strings.NewReader(sb.String())).ToSliceE()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []event{{1, "a"}, {2, "b"}}, res)
+}
+
+func Test_WriteJSONLines_Error(t *testing.T) {
+	err := itrzio.WriteJSONLines(io.Discard, itrz.Of[any](1, func() {}))
+
+	var typeErr *json.UnsupportedTypeError
+
+	assert.ErrorAs(t, err, &typeErr)
+	assert.ErrorContains(t, err, "encode JSON on line 2:")
+}
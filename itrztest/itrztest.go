@@ -0,0 +1,217 @@
+// Package itrztest provides helpers for testing that itrz.Seq implementations honour the
+// contract of range-over-func iterators.
+package itrztest
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/dustin10/itrz"
+)
+
+// MaxElements defines the maximum number of elements the helpers in this package will consume
+// from a single iteration. Infinite sequences should be bounded with itrz.Seq.Limit before they
+// are checked.
+const MaxElements = 100_000
+
+// goroutineSettleTimeout defines how long AssertNoGoroutineLeak waits for goroutines to exit.
+const goroutineSettleTimeout = time.Second
+
+// AssertYields asserts that ranging over the itrz.Seq yields exactly the wanted elements in order.
+// Returns true if the assertion passed.
+func AssertYields[A any](t testing.TB, seq itrz.Seq[A], want ...A) bool {
+	t.Helper()
+
+	got, ok := collect(t, seq)
+	if !ok {
+		return false
+	}
+
+	if len(got) == 0 && len(want) == 0 {
+		return true
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("itrz.Seq yielded %v, want %v", got, want)
+		return false
+	}
+
+	return true
+}
+
+// AssertStopsAfterFalse asserts that the itrz.Seq stops calling yield once yield has returned
+// false for the nth element. An itrz.Seq with fewer than n elements passes trivially. Returns true
+// if the assertion passed. Panics if n is less than one.
+func AssertStopsAfterFalse[A any](t testing.TB, seq itrz.Seq[A], n int) bool {
+	t.Helper()
+
+	if n < 1 {
+		panic("n must be at least one")
+	}
+
+	yielded := 0
+	stopped := false
+	extra := 0
+
+	seq(func(A) bool {
+		if stopped {
+			extra = extra + 1
+			return false
+		}
+
+		yielded = yielded + 1
+		if yielded >= n {
+			stopped = true
+			return false
+		}
+
+		return true
+	})
+
+	if extra > 0 {
+		t.Errorf("itrz.Seq called yield %d more time(s) after it returned false for element %d", extra, n)
+		return false
+	}
+
+	return true
+}
+
+// AssertEarlyTermination asserts that the itrz.Seq stops calling yield once yield has returned
+// false, at every possible prefix length of the itrz.Seq. Returns true if the assertion passed.
+func AssertEarlyTermination[A any](t testing.TB, seq itrz.Seq[A]) bool {
+	t.Helper()
+
+	all, ok := collect(t, seq)
+	if !ok {
+		return false
+	}
+
+	for n := 1; n <= len(all); n++ {
+		if !AssertStopsAfterFalse(t, seq, n) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AssertReiterable asserts that ranging over the itrz.Seq more than once, including after having
+// stopped early at every possible prefix length, yields identical elements each time. Returns
+// true if the assertion passed.
+func AssertReiterable[A any](t testing.TB, seq itrz.Seq[A]) bool {
+	t.Helper()
+
+	want, ok := collect(t, seq)
+	if !ok {
+		return false
+	}
+
+	if !AssertYields(t, seq, want...) {
+		return false
+	}
+
+	for n := 1; n <= len(want); n++ {
+		prefix := make([]A, 0, n)
+		seq(func(a A) bool {
+			if len(prefix) < n {
+				prefix = append(prefix, a)
+			}
+
+			return len(prefix) < n
+		})
+
+		if !reflect.DeepEqual(want[:n], prefix) {
+			t.Errorf("itrz.Seq yielded %v when stopped after %d element(s), want %v", prefix, n, want[:n])
+			return false
+		}
+
+		if !AssertYields(t, seq, want...) {
+			t.Errorf("itrz.Seq was not re-iterable after being stopped after %d element(s)", n)
+			return false
+		}
+	}
+
+	return true
+}
+
+// AssertNoGoroutineLeak asserts that every goroutine started while running the specified
+// function, e.g. by itrz.Pull or a concurrent itrz.Seq, has exited shortly after it returns.
+// Returns true if the assertion passed. It counts goroutines process wide, so it should not be
+// used from parallel tests.
+func AssertNoGoroutineLeak(t testing.TB, f func()) bool {
+	t.Helper()
+
+	before := runtime.NumGoroutine()
+
+	f()
+
+	deadline := time.Now().Add(goroutineSettleTimeout)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("%d goroutine(s) leaked", after-before)
+		return false
+	}
+
+	return true
+}
+
+// CheckSeq runs every contract check in this package against the itrz.Seq: it must be
+// re-iterable, it must stop after yield returns false at every prefix length and it must not leak
+// goroutines. Returns true if all of the checks passed.
+func CheckSeq[A any](t testing.TB, seq itrz.Seq[A]) bool {
+	t.Helper()
+
+	passed := true
+
+	noLeak := AssertNoGoroutineLeak(t, func() {
+		passed = AssertReiterable(t, seq) && AssertEarlyTermination(t, seq)
+	})
+
+	return passed && noLeak
+}
+
+// Fuzz registers a fuzz target that builds an itrz.Seq from the fuzzer provided input using the
+// specified function and runs CheckSeq against it. The seeds are added to the seed corpus.
+func Fuzz[A any](f *testing.F, build func(data []byte) itrz.Seq[A], seeds ...[]byte) {
+	f.Helper()
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		seq := build(data)
+
+		if AssertReiterable(t, seq) {
+			AssertEarlyTermination(t, seq)
+		}
+	})
+}
+
+// collect returns the elements yielded by the itrz.Seq, failing if it yields more than
+// MaxElements elements. The yield function is called directly rather than ranging over the
+// itrz.Seq so that a misbehaving one is reported instead of panicking.
+func collect[A any](t testing.TB, seq itrz.Seq[A]) ([]A, bool) {
+	t.Helper()
+
+	as := make([]A, 0)
+	seq(func(a A) bool {
+		if len(as) <= MaxElements {
+			as = append(as, a)
+		}
+
+		return len(as) <= MaxElements
+	})
+
+	if len(as) > MaxElements {
+		t.Errorf("itrz.Seq yielded more than %d elements, bound infinite sequences with Limit", MaxElements)
+		return nil, false
+	}
+
+	return as, true
+}
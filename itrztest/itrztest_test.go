@@ -0,0 +1,188 @@
+package itrztest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/itrztest"
+)
+
+// recorder is a testing.TB that records the errors reported to it rather than failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Failed() bool {
+	return len(r.errors) > 0
+}
+
+// ignoresYield returns a Seq that keeps calling yield after it has returned false.
+func ignoresYield(ns ...int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, n := range ns {
+			yield(n)
+		}
+	}
+}
+
+// sharedState returns a Seq whose position is shared between iterations.
+func sharedState(ns ...int) itrz.Seq[int] {
+	idx := 0
+
+	return func(yield func(int) bool) {
+		for ; idx < len(ns); idx++ {
+			if !yield(ns[idx]) {
+				return
+			}
+		}
+	}
+}
+
+// leaksPull returns a Seq that pulls from the source without ever stopping it.
+func leaksPull(ns ...int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		next, _ := itrz.Pull(itrz.All(ns))
+
+		for n, ok := next(); ok; n, ok = next() {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func Test_AssertYields(t *testing.T) {
+	tests := map[string]struct {
+		seq    itrz.Seq[int]
+		want   []int
+		expect bool
+	}{
+		"equal":      {seq: itrz.Of(1, 2, 3), want: []int{1, 2, 3}, expect: true},
+		"empty":      {seq: itrz.Empty[int](), expect: true},
+		"different":  {seq: itrz.Of(1, 2, 3), want: []int{1, 3, 2}},
+		"shorter":    {seq: itrz.Of(1, 2), want: []int{1, 2, 3}},
+		"longer":     {seq: itrz.Of(1, 2, 3, 4), want: []int{1, 2, 3}},
+		"unbounded":  {seq: itrz.Generate(func() int { return 1 }), want: []int{1}},
+		"want empty": {seq: itrz.Of(1)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+
+			assert.Equal(t, test.expect, itrztest.AssertYields(r, test.seq, test.want...))
+			assert.Equal(t, test.expect, len(r.errors) == 0)
+		})
+	}
+}
+
+func Test_AssertEarlyTermination(t *testing.T) {
+	tests := map[string]struct {
+		seq    itrz.Seq[int]
+		expect bool
+	}{
+		"well behaved":  {seq: itrz.Of(1, 2, 3), expect: true},
+		"empty":         {seq: itrz.Empty[int](), expect: true},
+		"ignores yield": {seq: ignoresYield(1, 2, 3)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+
+			assert.Equal(t, test.expect, itrztest.AssertEarlyTermination(r, test.seq))
+		})
+	}
+}
+
+func Test_AssertStopsAfterFalse(t *testing.T) {
+	r := &recorder{}
+
+	assert.True(t, itrztest.AssertStopsAfterFalse(r, ignoresYield(1, 2, 3), 3))
+	assert.False(t, itrztest.AssertStopsAfterFalse(r, ignoresYield(1, 2, 3), 2))
+	assert.Len(t, r.errors, 1)
+	assert.Panics(t, func() { itrztest.AssertStopsAfterFalse(r, itrz.Of(1), 0) })
+}
+
+func Test_AssertReiterable(t *testing.T) {
+	tests := map[string]struct {
+		seq    itrz.Seq[int]
+		expect bool
+	}{
+		"well behaved": {seq: itrz.Of(1, 2, 3), expect: true},
+		"empty":        {seq: itrz.Empty[int](), expect: true},
+		"shared state": {seq: sharedState(1, 2, 3)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+
+			assert.Equal(t, test.expect, itrztest.AssertReiterable(r, test.seq))
+		})
+	}
+}
+
+func Test_AssertNoGoroutineLeak(t *testing.T) {
+	r := &recorder{}
+
+	assert.True(t, itrztest.AssertNoGoroutineLeak(r, func() {
+		for range itrz.Of(1, 2, 3) {
+		}
+	}))
+
+	assert.False(t, itrztest.AssertNoGoroutineLeak(r, func() {
+		for range leaksPull(1, 2, 3) {
+			break
+		}
+	}))
+
+	assert.Len(t, r.errors, 1)
+}
+
+func Test_CheckSeq(t *testing.T) {
+	tests := map[string]struct {
+		seq    itrz.Seq[int]
+		expect bool
+	}{
+		"well behaved":  {seq: itrz.Of(1, 2, 3).Filter(func(n int) bool { return n > 1 }), expect: true},
+		"ignores yield": {seq: ignoresYield(1, 2, 3)},
+		"shared state":  {seq: sharedState(1, 2, 3)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+
+			assert.Equal(t, test.expect, itrztest.CheckSeq(r, test.seq))
+		})
+	}
+}
+
+func Test_CheckSeq_EarlierFailure(t *testing.T) {
+	r := &recorder{errors: []string{"unrelated failure"}}
+
+	assert.True(t, itrztest.CheckSeq(r, itrz.Of(1, 2, 3)))
+	assert.False(t, itrztest.CheckSeq(r, ignoresYield(1, 2, 3)))
+}
+
+func Fuzz_Chunk(f *testing.F) {
+	itrztest.Fuzz(f, func(data []byte) itrz.Seq[int] {
+		size := 1
+		if len(data) > 0 {
+			size = int(data[0])%4 + 1
+		}
+
+		return itrz.Map(itrz.Chunk(itrz.All(data), size), func(bs []byte) int { return len(bs) })
+	}, []byte{}, []byte{1, 2, 3}, []byte{3, 1, 4, 1, 5, 9, 2, 6})
+}
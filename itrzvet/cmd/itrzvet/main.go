@@ -0,0 +1,25 @@
+// Command itrzvet reports misuse of the itrz iterators.
+//
+// It is built from its own module, so that importers of itrz do not depend on the analysis
+// framework, and can be installed with:
+//
+//	go install github.com/dustin10/itrz/itrzvet/cmd/itrzvet@latest
+//
+// Usage:
+//
+//	itrzvet [flags] [packages]
+//
+// It can also be run by go vet:
+//
+//	go vet -vettool=$(which itrzvet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/dustin10/itrz/itrzvet"
+)
+
+func main() {
+	singlechecker.Main(itrzvet.Analyzer)
+}
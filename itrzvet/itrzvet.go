@@ -0,0 +1,575 @@
+// Package itrzvet defines an Analyzer that reports common misuse of the itrz iterators.
+//
+// The Analyzer reports:
+//
+//   - calls to itrz.Pull, itrz.Pull2, iter.Pull or iter.Pull2 whose stop function is discarded or
+//     never deferred in a scope enclosing the call, which leaks the goroutine backing the pull
+//     iterator
+//   - custom sequences that ignore the bool returned by yield and may call it again afterwards,
+//     which causes a panic when the consumer stops ranging over them early
+//   - calls to maybe.Maybe.Get that are not guarded by a call to IsPresent or IsEmpty on the same
+//     variable, which panics if the maybe.Maybe is empty
+package itrzvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	itrzPath  = "github.com/dustin10/itrz"
+	maybePath = "github.com/dustin10/itrz/maybe"
+	iterPath  = "iter"
+)
+
+// Analyzer reports misuse of the itrz iterators.
+var Analyzer = &analysis.Analyzer{
+	Name:     "itrzvet",
+	Doc:      "report misuse of the itrz iterators: undeferred Pull stop functions, ignored yield results and unchecked maybe.Maybe.Get calls",
+	URL:      "https://pkg.go.dev/github.com/dustin10/itrz/itrzvet",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	checkPull(pass, insp)
+	checkYield(pass, insp)
+	checkGet(pass, insp)
+
+	return nil, nil
+}
+
+// checkPull reports calls to a Pull function whose stop function is discarded, or is assigned to a
+// local variable that is never deferred in a scope enclosing the call and never handed off to
+// other code.
+func checkPull(pass *analysis.Pass, insp *inspector.Inspector) {
+	filter := []ast.Node{(*ast.AssignStmt)(nil)}
+
+	insp.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		assign := n.(*ast.AssignStmt)
+		if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok || !isPull(pass, call) {
+			return true
+		}
+
+		stop, ok := assign.Lhs[1].(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		if stop.Name == "_" {
+			pass.Reportf(call.Pos(), "stop function returned by Pull is discarded")
+			return true
+		}
+
+		obj := pass.TypesInfo.ObjectOf(stop)
+		if obj == nil {
+			return true
+		}
+
+		body := enclosingBody(stack)
+		if body == nil || obj.Pos() < body.Pos() || obj.Pos() >= body.End() {
+			// The stop function is stored in a variable declared outside of the function, so
+			// releasing the pull iterator is the responsibility of other code.
+			return true
+		}
+
+		if !isReleased(pass, body, assign, obj) {
+			pass.Reportf(call.Pos(), "stop function returned by Pull should be deferred")
+		}
+
+		return true
+	})
+}
+
+// isPull returns true if the call is to one of the Pull functions of the itrz or iter packages.
+func isPull(pass *analysis.Pass, call *ast.CallExpr) bool {
+	f, ok := calledFunc(pass, call).(*types.Func)
+	if !ok || f.Pkg() == nil {
+		return false
+	}
+
+	switch f.Pkg().Path() {
+	case itrzPath, iterPath:
+		return f.Name() == "Pull" || f.Name() == "Pull2"
+	default:
+		return false
+	}
+}
+
+// isReleased returns true if the stop function is called from a defer statement in a block that
+// encloses the assignment, or is used other than by calling it or assigning to it, e.g. it is
+// returned or stored, in which case releasing the pull iterator is the responsibility of other
+// code.
+func isReleased(pass *analysis.Pass, body *ast.BlockStmt, assign *ast.AssignStmt, stop types.Object) bool {
+	called := make(map[*ast.Ident]bool)
+	assigned := make(map[*ast.Ident]bool)
+	deferred := false
+	released := false
+
+	var stack []ast.Node
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					assigned[id] = true
+				}
+			}
+		case *ast.CallExpr:
+			if id, ok := ast.Unparen(n.Fun).(*ast.Ident); ok {
+				called[id] = true
+			}
+		case *ast.Ident:
+			if pass.TypesInfo.Uses[n] != stop || assigned[n] {
+				return true
+			}
+
+			if d, ok := deferScope(stack); ok {
+				deferred = deferred || encloses(d, assign)
+			} else if !called[n] {
+				released = true
+			}
+		}
+
+		return true
+	})
+
+	return deferred || released
+}
+
+// deferScope returns the block that contains the defer statement enclosing the node at the top of
+// the stack, if the node is part of a defer statement of the function whose body is at the bottom
+// of the stack.
+func deferScope(stack []ast.Node) (ast.Node, bool) {
+	for i := 1; i < len(stack); i++ {
+		switch stack[i].(type) {
+		case *ast.FuncLit:
+			return nil, false
+		case *ast.DeferStmt:
+			return stack[i-1], true
+		}
+	}
+
+	return nil, false
+}
+
+// encloses returns true if the source range of the outer node contains the inner node.
+func encloses(outer, inner ast.Node) bool {
+	return outer.Pos() <= inner.Pos() && inner.End() <= outer.End()
+}
+
+// checkYield reports calls to the yield function of a sequence whose result is discarded, unless
+// the call is the last statement the sequence executes.
+func checkYield(pass *analysis.Pass, insp *inspector.Inspector) {
+	yields := make(map[types.Object]bool)
+
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}, func(n ast.Node) {
+		var params *ast.FieldList
+		var sig *types.Signature
+
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			params = n.Type.Params
+			if obj := pass.TypesInfo.Defs[n.Name]; obj != nil {
+				sig, _ = obj.Type().(*types.Signature)
+			}
+		case *ast.FuncLit:
+			params = n.Type.Params
+			sig, _ = pass.TypesInfo.TypeOf(n).(*types.Signature)
+		}
+
+		if sig == nil || !isSeqFunc(sig) || len(params.List) != 1 || len(params.List[0].Names) != 1 {
+			return
+		}
+
+		if obj := pass.TypesInfo.Defs[params.List[0].Names[0]]; obj != nil {
+			yields[obj] = true
+		}
+	})
+
+	if len(yields) == 0 {
+		return
+	}
+
+	report := func(expr ast.Expr, stack []ast.Node) {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			return
+		}
+
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok || !yields[pass.TypesInfo.Uses[id]] || isFinal(pass, stack, pass.TypesInfo.Uses[id]) {
+			return
+		}
+
+		pass.Reportf(call.Pos(), "result of %s is ignored, iteration must stop when it returns false", id.Name)
+	}
+
+	filter := []ast.Node{(*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}
+
+	insp.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			report(n.X, stack)
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 && isBlank(n.Lhs[0]) {
+				report(n.Rhs[0], stack)
+			}
+		}
+
+		return true
+	})
+}
+
+// isFinal returns true if the statement at the top of the stack is the last statement executed by
+// the sequence that declares the yield function, in which case ignoring the result of yield is
+// harmless because nothing else is yielded after it.
+func isFinal(pass *analysis.Pass, stack []ast.Node, yield types.Object) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		child, parent := stack[i], stack[i-1]
+
+		switch parent := parent.(type) {
+		case *ast.FuncLit:
+			return declares(pass, parent.Type, yield)
+		case *ast.FuncDecl:
+			return declares(pass, parent.Type, yield)
+		case *ast.ForStmt, *ast.RangeStmt:
+			return false
+		case *ast.BlockStmt:
+			if i > 1 {
+				switch stack[i-2].(type) {
+				case *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+					continue
+				}
+			}
+
+			if parent.List[len(parent.List)-1] != child {
+				return false
+			}
+		case *ast.CaseClause:
+			if parent.Body[len(parent.Body)-1] != child {
+				return false
+			}
+		case *ast.CommClause:
+			if parent.Body[len(parent.Body)-1] != child {
+				return false
+			}
+		}
+	}
+
+	return false
+}
+
+// declares returns true if the object is one of the parameters of the function type.
+func declares(pass *analysis.Pass, fun *ast.FuncType, obj types.Object) bool {
+	for _, field := range fun.Params.List {
+		for _, name := range field.Names {
+			if pass.TypesInfo.Defs[name] == obj {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isSeqFunc returns true if the signature has the shape of an iter.Seq or iter.Seq2, i.e. it
+// accepts a single function that returns a bool and returns nothing.
+func isSeqFunc(sig *types.Signature) bool {
+	if sig.Params().Len() != 1 || sig.Results().Len() != 0 {
+		return false
+	}
+
+	yield, ok := sig.Params().At(0).Type().Underlying().(*types.Signature)
+	if !ok || yield.Results().Len() != 1 {
+		return false
+	}
+
+	b, ok := yield.Results().At(0).Type().Underlying().(*types.Basic)
+
+	return ok && b.Kind() == types.Bool
+}
+
+// checkGet reports calls to maybe.Maybe.Get on a value that is not guarded by a check with
+// IsPresent or IsEmpty, i.e. the call can execute without the check having proven that the
+// maybe.Maybe contains a value.
+func checkGet(pass *analysis.Pass, insp *inspector.Inspector) {
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		call := n.(*ast.CallExpr)
+
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Get" || !isMaybe(pass.TypesInfo.TypeOf(sel.X)) {
+			return true
+		}
+
+		if !isChecked(pass, stack, sel.X) {
+			pass.Reportf(call.Pos(), "maybe.Maybe.Get called without checking IsPresent or IsEmpty first")
+		}
+
+		return true
+	})
+}
+
+// isMaybe returns true if the type is an instantiation of maybe.Maybe.
+func isMaybe(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Origin().Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == maybePath && obj.Name() == "Maybe"
+}
+
+// isChecked returns true if the Get call at the top of the stack only executes when a check of
+// the receiver with IsPresent or IsEmpty has proven that it contains a value. The check proves this
+// when the call is in the body of an if statement or case clause whose condition is true only if a
+// value is present, in the else branch of one that is true only if no value is present, on the
+// right hand side of a && or || operator guarded in the same way or after an if statement that
+// returns or otherwise exits the enclosing block if no value is present.
+func isChecked(pass *analysis.Pass, stack []ast.Node, recv ast.Expr) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		child, parent := stack[i], stack[i-1]
+
+		switch parent := parent.(type) {
+		case *ast.IfStmt:
+			if child == parent.Body && implies(pass, parent.Cond, true, recv) {
+				return true
+			}
+
+			if child == parent.Else && implies(pass, parent.Cond, false, recv) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != parent.Y {
+				continue
+			}
+
+			if parent.Op == token.LAND && implies(pass, parent.X, true, recv) {
+				return true
+			}
+
+			if parent.Op == token.LOR && implies(pass, parent.X, false, recv) {
+				return true
+			}
+		case *ast.CaseClause:
+			if guardedBefore(pass, parent.Body, child, recv) {
+				return true
+			}
+
+			if i < 3 || !isTaglessSwitch(stack[i-3]) || !isStmtOf(parent.Body, child) {
+				continue
+			}
+
+			for _, expr := range parent.List {
+				if implies(pass, expr, true, recv) {
+					return true
+				}
+			}
+		case *ast.CommClause:
+			if guardedBefore(pass, parent.Body, child, recv) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if guardedBefore(pass, parent.List, child, recv) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// guardedBefore returns true if a statement preceding the child in the list is an if statement
+// that exits the list when the receiver contains no value.
+func guardedBefore(pass *analysis.Pass, list []ast.Stmt, child ast.Node, recv ast.Expr) bool {
+	for _, stmt := range list {
+		if stmt == child {
+			return false
+		}
+
+		guard, ok := stmt.(*ast.IfStmt)
+		if ok && guard.Else == nil && exits(pass, guard.Body) && implies(pass, guard.Cond, false, recv) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// implies returns true if the expression evaluating to the specified result proves that the
+// receiver contains a value.
+func implies(pass *analysis.Pass, expr ast.Expr, result bool, recv ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.UnaryExpr:
+		return e.Op == token.NOT && implies(pass, e.X, !result, recv)
+	case *ast.BinaryExpr:
+		switch {
+		case e.Op == token.LAND && result, e.Op == token.LOR && !result:
+			return implies(pass, e.X, result, recv) || implies(pass, e.Y, result, recv)
+		case e.Op == token.LAND, e.Op == token.LOR:
+			return implies(pass, e.X, result, recv) && implies(pass, e.Y, result, recv)
+		}
+	case *ast.CallExpr:
+		sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr)
+		if !ok || !isMaybe(pass.TypesInfo.TypeOf(sel.X)) || !sameValue(pass, sel.X, recv) {
+			return false
+		}
+
+		switch sel.Sel.Name {
+		case "IsPresent":
+			return result
+		case "IsEmpty":
+			return !result
+		}
+	}
+
+	return false
+}
+
+// sameValue returns true if the two expressions refer to the same variable or to the same field
+// of the same variable. Any other expression, such as a function call, may produce a different
+// value each time it is evaluated and is never considered the same.
+func sameValue(pass *analysis.Pass, a, b ast.Expr) bool {
+	switch a := ast.Unparen(a).(type) {
+	case *ast.Ident:
+		b, ok := ast.Unparen(b).(*ast.Ident)
+		return ok && pass.TypesInfo.ObjectOf(a) != nil && pass.TypesInfo.ObjectOf(a) == pass.TypesInfo.ObjectOf(b)
+	case *ast.SelectorExpr:
+		b, ok := ast.Unparen(b).(*ast.SelectorExpr)
+		return ok && pass.TypesInfo.ObjectOf(a.Sel) == pass.TypesInfo.ObjectOf(b.Sel) && sameValue(pass, a.X, b.X)
+	case *ast.StarExpr:
+		b, ok := ast.Unparen(b).(*ast.StarExpr)
+		return ok && sameValue(pass, a.X, b.X)
+	default:
+		return false
+	}
+}
+
+// exits returns true if the block ends with a statement that transfers control out of it.
+func exits(pass *analysis.Pass, block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+
+	switch stmt := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok {
+			return false
+		}
+
+		_, builtin := pass.TypesInfo.Uses[id].(*types.Builtin)
+
+		return builtin && id.Name == "panic"
+	default:
+		return false
+	}
+}
+
+// isTaglessSwitch returns true if the node is a switch statement without a tag expression.
+func isTaglessSwitch(n ast.Node) bool {
+	sw, ok := n.(*ast.SwitchStmt)
+	return ok && sw.Tag == nil
+}
+
+// isStmtOf returns true if the node is one of the statements in the list.
+func isStmtOf(list []ast.Stmt, n ast.Node) bool {
+	for _, stmt := range list {
+		if stmt == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calledFunc returns the object of the function or method being called, ignoring any explicit
+// type arguments.
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) types.Object {
+	fun := ast.Unparen(call.Fun)
+
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return pass.TypesInfo.Uses[f]
+	case *ast.SelectorExpr:
+		return pass.TypesInfo.Uses[f.Sel]
+	default:
+		return nil
+	}
+}
+
+// enclosingBody returns the body of the innermost function in the stack.
+func enclosingBody(stack []ast.Node) *ast.BlockStmt {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			return n.Body
+		case *ast.FuncLit:
+			return n.Body
+		}
+	}
+
+	return nil
+}
+
+// isBlank returns true if the expression is the blank identifier.
+func isBlank(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == "_"
+}
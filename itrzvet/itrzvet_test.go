@@ -0,0 +1,25 @@
+package itrzvet_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/dustin10/itrz/itrzvet"
+)
+
+func Test_Analyzer(t *testing.T) {
+	tests := map[string]struct {
+		pkg string
+	}{
+		"Pull stop function": {pkg: "pull"},
+		"ignored yield":      {pkg: "yield"},
+		"unchecked Get":      {pkg: "get"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			analysistest.Run(t, analysistest.TestData(), itrzvet.Analyzer, test.pkg)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package get
+
+import "github.com/dustin10/itrz/maybe"
+
+type holder struct {
+	value maybe.Maybe[int]
+}
+
+func present(m maybe.Maybe[int]) int {
+	if m.IsPresent() {
+		return m.Get()
+	}
+
+	return 0
+}
+
+func empty(m maybe.Maybe[int]) int {
+	if m.IsEmpty() {
+		return 0
+	}
+
+	return m.Get()
+}
+
+func field(h holder) int {
+	if h.value.IsPresent() {
+		return h.value.Get()
+	}
+
+	return 0
+}
+
+func checkedInClosure(m maybe.Maybe[int]) func() int {
+	if m.IsEmpty() {
+		return nil
+	}
+
+	return func() int {
+		return m.Get()
+	}
+}
+
+func unchecked(m maybe.Maybe[int]) int {
+	return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+}
+
+func checkedAfter(m maybe.Maybe[int]) int {
+	v := m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	if m.IsPresent() {
+		return v
+	}
+
+	return 0
+}
+
+func otherValue(a, b maybe.Maybe[int]) int {
+	if a.IsPresent() {
+		return b.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	}
+
+	return 0
+}
+
+func call() int {
+	return maybe.Just(1).Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+}
+
+func checkedBothEarly(a, b maybe.Maybe[int]) int {
+	if a.IsEmpty() || b.IsEmpty() {
+		return 0
+	}
+
+	return a.Get() + b.Get()
+}
+
+func notPresentEarly(m maybe.Maybe[int]) int {
+	if !m.IsPresent() {
+		panic("no value")
+	}
+
+	return m.Get()
+}
+
+func elseBranch(m maybe.Maybe[int]) int {
+	if m.IsEmpty() {
+		return 0
+	} else {
+		return m.Get()
+	}
+}
+
+func and(m maybe.Maybe[int]) bool {
+	return m.IsPresent() && m.Get() > 0
+}
+
+func or(m maybe.Maybe[int]) bool {
+	return m.IsEmpty() || m.Get() > 0
+}
+
+func tagless(m maybe.Maybe[int]) int {
+	switch {
+	case m.IsPresent():
+		return m.Get()
+	default:
+		return 0
+	}
+}
+
+func loop(ms []maybe.Maybe[int]) int {
+	sum := 0
+	for _, m := range ms {
+		if m.IsEmpty() {
+			continue
+		}
+
+		sum += m.Get()
+	}
+
+	return sum
+}
+
+func unrelatedBranch(m maybe.Maybe[int], cond bool) int {
+	if cond && m.IsEmpty() {
+		return 0
+	}
+
+	return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+}
+
+func notExited(m maybe.Maybe[int]) int {
+	if m.IsEmpty() {
+		println("empty")
+	}
+
+	return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+}
+
+func checkedInOtherBranch(m maybe.Maybe[int], cond bool) int {
+	if cond {
+		if m.IsEmpty() {
+			return 0
+		}
+	}
+
+	return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+}
+
+func wrongBranch(m maybe.Maybe[int]) int {
+	if m.IsPresent() {
+		return 0
+	} else {
+		return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	}
+}
+
+func shadowed(m maybe.Maybe[int]) int {
+	if m.IsPresent() {
+		m := maybe.Nothing[int]()
+		return m.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	}
+
+	return 0
+}
+
+func otherField(a, b holder) int {
+	if a.value.IsPresent() {
+		return b.value.Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	}
+
+	return 0
+}
+
+func callChecked() int {
+	if maybe.Just(1).IsPresent() {
+		return maybe.Just(1).Get() // want "maybe.Maybe.Get called without checking IsPresent or IsEmpty first"
+	}
+
+	return 0
+}
@@ -0,0 +1,13 @@
+package itrz
+
+type Seq[A any] func(yield func(A) bool)
+
+type Seq2[A, B any] func(yield func(A, B) bool)
+
+func Pull[A any](seq Seq[A]) (func() (A, bool), func()) {
+	return nil, nil
+}
+
+func Pull2[A, B any](seq Seq2[A, B]) (func() (A, B, bool), func()) {
+	return nil, nil
+}
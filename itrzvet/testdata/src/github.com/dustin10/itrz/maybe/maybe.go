@@ -0,0 +1,26 @@
+package maybe
+
+type Maybe[A any] struct {
+	value   A
+	present bool
+}
+
+func Just[A any](value A) Maybe[A] {
+	return Maybe[A]{value: value, present: true}
+}
+
+func Nothing[A any]() Maybe[A] {
+	return Maybe[A]{}
+}
+
+func (m Maybe[A]) Get() A {
+	return m.value
+}
+
+func (m Maybe[A]) IsPresent() bool {
+	return m.present
+}
+
+func (m Maybe[A]) IsEmpty() bool {
+	return !m.present
+}
@@ -0,0 +1,135 @@
+package pull
+
+import (
+	"iter"
+
+	"github.com/dustin10/itrz"
+)
+
+func deferred(seq itrz.Seq[int]) {
+	next, stop := itrz.Pull(seq)
+	defer stop()
+
+	next()
+}
+
+func deferredInClosure(seq itrz.Seq[int]) {
+	next, stop := itrz.Pull(seq)
+	defer func() {
+		stop()
+	}()
+
+	next()
+}
+
+func returned(seq itrz.Seq[int]) (func() (int, bool), func()) {
+	next, stop := itrz.Pull(seq)
+
+	return next, stop
+}
+
+func assigned(seq itrz.Seq[int]) {
+	var stop func()
+
+	var next func() (int, bool)
+	next, stop = itrz.Pull(seq) // want "stop function returned by Pull should be deferred"
+
+	next()
+	stop()
+}
+
+func assignedDeferred(seq itrz.Seq[int]) {
+	var stop func()
+	defer func() {
+		if stop != nil {
+			stop()
+		}
+	}()
+
+	var next func() (int, bool)
+	next, stop = itrz.Pull(seq)
+
+	next()
+}
+
+func assignedInLoop(seqs []itrz.Seq[int]) {
+	var next func() (int, bool)
+	var stop func()
+
+	for _, seq := range seqs {
+		next, stop = itrz.Pull(seq) // want "stop function returned by Pull should be deferred"
+
+		next()
+		stop()
+	}
+}
+
+var global func()
+
+func assignedToGlobal(seq itrz.Seq[int]) {
+	var next func() (int, bool)
+	next, global = itrz.Pull(seq)
+
+	next()
+}
+
+func assignedToResult(seq itrz.Seq[int]) (next func() (int, bool), stop func()) {
+	next, stop = itrz.Pull(seq)
+
+	return
+}
+
+func deferredInNestedBlock(seq itrz.Seq[int], cond bool) {
+	if cond {
+		next, stop := itrz.Pull(seq)
+		defer stop()
+
+		next()
+	}
+}
+
+func deferredInBranch(seq itrz.Seq[int], cond bool) {
+	next, stop := itrz.Pull(seq) // want "stop function returned by Pull should be deferred"
+	if cond {
+		defer stop()
+	}
+
+	next()
+}
+
+func deferredInOtherClosure(seq itrz.Seq[int]) {
+	next, stop := itrz.Pull(seq) // want "stop function returned by Pull should be deferred"
+
+	func() {
+		defer stop()
+	}()
+
+	next()
+}
+
+func notDeferred(seq itrz.Seq[int]) {
+	next, stop := itrz.Pull(seq) // want "stop function returned by Pull should be deferred"
+
+	next()
+	stop()
+}
+
+func notDeferred2(seq itrz.Seq2[int, string]) {
+	next, stop := itrz.Pull2(seq) // want "stop function returned by Pull should be deferred"
+
+	if _, _, ok := next(); ok {
+		stop()
+	}
+}
+
+func discarded(seq itrz.Seq[int]) {
+	next, _ := itrz.Pull(seq) // want "stop function returned by Pull is discarded"
+
+	next()
+}
+
+func stdlib(seq iter.Seq[int]) {
+	next, _ := iter.Pull(seq) // want "stop function returned by Pull is discarded"
+
+	next()
+}
@@ -0,0 +1,93 @@
+package yield
+
+import "github.com/dustin10/itrz"
+
+func checked(ns []int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, n := range ns {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func ignored(ns []int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, n := range ns {
+			yield(n) // want "result of yield is ignored, iteration must stop when it returns false"
+		}
+	}
+}
+
+func blank(ns []int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, n := range ns {
+			_ = yield(n) // want "result of yield is ignored, iteration must stop when it returns false"
+		}
+	}
+}
+
+func ignoredBeforeMore(a, b int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		yield(a) // want "result of yield is ignored, iteration must stop when it returns false"
+		yield(b)
+	}
+}
+
+func ignoredInNestedClosure(ns []int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		each := func(n int) {
+			yield(n) // want "result of yield is ignored, iteration must stop when it returns false"
+		}
+
+		for _, n := range ns {
+			each(n)
+		}
+	}
+}
+
+func final(ns []int, last int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, n := range ns {
+			if !yield(n) {
+				return
+			}
+		}
+
+		if last > 0 {
+			yield(last)
+		}
+	}
+}
+
+func finalSwitch(n int) itrz.Seq[int] {
+	return func(yield func(int) bool) {
+		switch {
+		case n > 0:
+			yield(n)
+		default:
+			yield(-n)
+		}
+	}
+}
+
+func seq2(m map[string]int) itrz.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for k, v := range m {
+			yield(k, v) // want "result of yield is ignored, iteration must stop when it returns false"
+		}
+	}
+}
+
+func source(yield func(int) bool) {
+	for n := range 3 {
+		yield(n) // want "result of yield is ignored, iteration must stop when it returns false"
+	}
+}
+
+func notASeq(f func(int) bool) bool {
+	f(1)
+
+	return f(2)
+}
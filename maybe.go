@@ -0,0 +1,55 @@
+package itrz
+
+import (
+	"github.com/dustin10/itrz/fn"
+	"github.com/dustin10/itrz/maybe"
+)
+
+// CatMaybes returns a Seq that yields the values contained in the maybe.Maybe elements yielded
+// by the Seq, skipping any that are empty.
+func CatMaybes[A any](seq Seq[maybe.Maybe[A]]) Seq[A] {
+	return FilterMap(seq, func(m maybe.Maybe[A]) maybe.Maybe[A] { return m })
+}
+
+// FilterMap returns a Seq that applies the function to each element yielded by the Seq and
+// yields the values contained in the resulting maybe.Maybe instances, skipping any that are
+// empty.
+func FilterMap[A, B any](seq Seq[A], f fn.Function[A, maybe.Maybe[B]]) Seq[B] {
+	return func(yield func(B) bool) {
+		for a := range seq {
+			if m := f(a); m.IsPresent() && !yield(m.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// FromMaybe returns a Seq that yields the value contained in the maybe.Maybe if present, or no
+// elements if it is empty.
+func FromMaybe[A any](m maybe.Maybe[A]) Seq[A] {
+	return Seq[A](m.All())
+}
+
+// Sequence returns a maybe.Maybe containing a slice of the values contained in the maybe.Maybe
+// elements yielded by the Seq. If any element is empty then iteration stops and an empty
+// maybe.Maybe is returned.
+func Sequence[A any](seq Seq[maybe.Maybe[A]]) maybe.Maybe[[]A] {
+	return Traverse(seq, func(m maybe.Maybe[A]) maybe.Maybe[A] { return m })
+}
+
+// Traverse applies the function to each element yielded by the Seq and returns a maybe.Maybe
+// containing a slice of the resulting values. If the function returns an empty maybe.Maybe for
+// any element then iteration stops and an empty maybe.Maybe is returned.
+func Traverse[A, B any](seq Seq[A], f fn.Function[A, maybe.Maybe[B]]) maybe.Maybe[[]B] {
+	bs := make([]B, 0)
+	for a := range seq {
+		m := f(a)
+		if m.IsEmpty() {
+			return maybe.Nothing[[]B]()
+		}
+
+		bs = append(bs, m.Get())
+	}
+
+	return maybe.Just(bs)
+}
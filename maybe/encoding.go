@@ -0,0 +1,115 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dustin10/itrz/internal/text"
+)
+
+// yamlNullTag defines the resolved tag of a YAML null value.
+const yamlNullTag = "!!null"
+
+// MarshalText converts the value in the Maybe, if present, to it's textual representation. A value
+// that implements encoding.TextMarshaler is converted using it, a string is converted as is and
+// any other value is converted to JSON. If no value is present then the textual representation
+// is empty, so a Maybe containing an empty string is indistinguishable from an empty Maybe, as
+// with FromString.
+func (m Maybe[_]) MarshalText() ([]byte, error) {
+	if !m.present {
+		return []byte{}, nil
+	}
+
+	data, err := text.Marshal(m.value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Maybe value to text: %w", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText converts the text to the value contained in the Maybe. Empty text results in an
+// empty Maybe.
+func (m *Maybe[A]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*m = Nothing[A]()
+		return nil
+	}
+
+	err := text.Unmarshal(data, &m.value)
+	if err != nil {
+		return fmt.Errorf("unmarshal Maybe value from text: %w", err)
+	}
+
+	m.present = true
+
+	return nil
+}
+
+// MarshalYAML converts the value in the Maybe, if present, to it's YAML representation. If no
+// value is present then the YAML representation is null.
+func (m Maybe[_]) MarshalYAML() (any, error) {
+	if !m.present {
+		return nil, nil
+	}
+
+	return m.value, nil
+}
+
+// UnmarshalYAML converts the YAML node to the value contained in the Maybe if present. A null node
+// results in an empty Maybe when this method is invoked directly, but unlike UnmarshalJSON, a null
+// value does not reset a Maybe that is decoded as part of a larger document, because yaml.v3 never
+// invokes an unmarshaler for null nodes and leaves struct fields untouched. Decode into a zero value
+// when null must clear a Maybe that may already contain a value.
+func (m *Maybe[A]) UnmarshalYAML(node *yaml.Node) error {
+	if node.ShortTag() == yamlNullTag {
+		*m = Nothing[A]()
+		return nil
+	}
+
+	err := node.Decode(&m.value)
+	if err != nil {
+		return fmt.Errorf("unmarshal Maybe value from YAML: %w", err)
+	}
+
+	m.present = true
+
+	return nil
+}
+
+// GobEncode converts the value in the Maybe, if present, to it's gob representation. If no value
+// is present then the gob representation is empty.
+func (m Maybe[_]) GobEncode() ([]byte, error) {
+	if !m.present {
+		return []byte{}, nil
+	}
+
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(&m.value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Maybe value to gob: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode converts the gob bytes to the value contained in the Maybe if present.
+func (m *Maybe[A]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		*m = Nothing[A]()
+		return nil
+	}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&m.value)
+	if err != nil {
+		return fmt.Errorf("unmarshal Maybe value from gob: %w", err)
+	}
+
+	m.present = true
+
+	return nil
+}
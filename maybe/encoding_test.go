@@ -0,0 +1,174 @@
+package maybe_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dustin10/itrz/maybe"
+)
+
+type config struct {
+	Name    maybe.Maybe[string] `yaml:"name"`
+	Port    maybe.Maybe[int]    `yaml:"port"`
+	Enabled maybe.Maybe[bool]   `yaml:"enabled"`
+}
+
+func Test_MarshalText(t *testing.T) {
+	tests := map[string]struct {
+		value  interface{ MarshalText() ([]byte, error) }
+		expect string
+	}{
+		"Nothing":       {value: maybe.Nothing[int](), expect: ""},
+		"string":        {value: maybe.Just("value"), expect: "value"},
+		"int":           {value: maybe.Just(42), expect: "42"},
+		"bool":          {value: maybe.Just(false), expect: "false"},
+		"TextMarshaler": {value: maybe.Just(netip.MustParseAddr("10.0.0.1")), expect: "10.0.0.1"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := test.value.MarshalText()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, string(res))
+		})
+	}
+}
+
+func Test_UnmarshalText(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		expect  int
+		present bool
+	}{
+		"empty":     {value: "", expect: -1},
+		"non-empty": {value: "42", expect: 42, present: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := maybe.Just(7)
+
+			err := m.UnmarshalText([]byte(test.value))
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.present, m.IsPresent())
+			assert.Equal(t, test.expect, m.Or(-1))
+		})
+	}
+
+	var addr maybe.Maybe[netip.Addr]
+
+	assert.Nil(t, addr.UnmarshalText([]byte("10.0.0.1")))
+	assert.Equal(t, maybe.Just(netip.MustParseAddr("10.0.0.1")), addr)
+
+	var m maybe.Maybe[int]
+
+	assert.ErrorContains(t, m.UnmarshalText([]byte("x")), "unmarshal Maybe value from text:")
+}
+
+func Test_YAML(t *testing.T) {
+	tests := map[string]struct {
+		value config
+		yaml  string
+	}{
+		"present": {
+			value: config{Name: maybe.Just("svc"), Port: maybe.Just(8080), Enabled: maybe.Just(false)},
+			yaml:  "name: svc\nport: 8080\nenabled: false\n",
+		},
+		"empty": {
+			value: config{},
+			yaml:  "name: null\nport: null\nenabled: null\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := yaml.Marshal(test.value)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.yaml, string(res))
+
+			var c config
+
+			assert.Nil(t, yaml.Unmarshal(res, &c))
+			assert.Equal(t, test.value, c)
+		})
+	}
+}
+
+func Test_UnmarshalYAML(t *testing.T) {
+	tests := map[string]struct {
+		yaml   string
+		expect config
+	}{
+		"absent fields": {yaml: "name: svc\n", expect: config{Name: maybe.Just("svc")}},
+		"null fields":   {yaml: "name: ~\nport:\n", expect: config{}},
+		"empty string":  {yaml: "name: \"\"\n", expect: config{Name: maybe.Just("")}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var c config
+
+			assert.Nil(t, yaml.Unmarshal([]byte(test.yaml), &c))
+			assert.Equal(t, test.expect, c)
+		})
+	}
+
+	var c config
+
+	err := yaml.Unmarshal([]byte("port: x\n"), &c)
+
+	assert.ErrorContains(t, err, "unmarshal Maybe value from YAML:")
+}
+
+func Test_UnmarshalYAML_NullIntoPresent(t *testing.T) {
+	c := config{Name: maybe.Just("svc"), Port: maybe.Just(8080)}
+
+	assert.Nil(t, yaml.Unmarshal([]byte("port: null\n"), &c))
+	assert.Equal(t, config{Name: maybe.Just("svc"), Port: maybe.Just(8080)}, c, "yaml.v3 leaves the field untouched for null")
+
+	m := maybe.Just(8080)
+
+	var node yaml.Node
+
+	assert.Nil(t, yaml.Unmarshal([]byte("null"), &node))
+	assert.Nil(t, m.UnmarshalYAML(node.Content[0]))
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_Gob(t *testing.T) {
+	tests := map[string]struct {
+		value config
+	}{
+		"present":    {value: config{Name: maybe.Just("svc"), Port: maybe.Just(8080), Enabled: maybe.Just(true)}},
+		"zero value": {value: config{Name: maybe.Just(""), Port: maybe.Just(0), Enabled: maybe.Just(false)}},
+		"empty":      {value: config{}},
+		"mixed":      {value: config{Port: maybe.Just(1)}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			assert.Nil(t, gob.NewEncoder(&buf).Encode(test.value))
+
+			var c config
+
+			assert.Nil(t, gob.NewDecoder(&buf).Decode(&c))
+			assert.Equal(t, test.value, c)
+		})
+	}
+}
+
+func Test_GobDecode_Invalid(t *testing.T) {
+	var m maybe.Maybe[int]
+
+	assert.ErrorContains(t, m.GobDecode([]byte("x")), "unmarshal Maybe value from gob:")
+}
@@ -0,0 +1,255 @@
+package maybe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Field encapsulates an optional value that distinguishes between being unset, being explicitly
+// set to null and being set to a value. It is intended for the fields of structs that are decoded
+// from partial updates, such as JSON merge patch documents, where a missing field means "leave as
+// is" and a null field means "clear".
+//
+// The zero value of a Field is unset. Field implements IsZero so that the encoding/json omitzero
+// option, which is supported when building with Go 1.24 or later, omits an unset Field. The
+// omitempty option has no effect on it as it is a struct type.
+type Field[A any] struct {
+	value A
+	state fieldState
+}
+
+// fieldState defines the states that a Field can be in.
+type fieldState uint8
+
+const (
+	fieldUnset fieldState = iota
+	fieldNull
+	fieldPresent
+)
+
+// FieldOf creates a new Field that is set to the specified value.
+func FieldOf[A any](value A) Field[A] {
+	return Field[A]{
+		value: value,
+		state: fieldPresent,
+	}
+}
+
+// FieldFromMaybe creates a new Field from a Maybe. The Field is set to the value contained in the
+// Maybe if it is present, otherwise it is set to null.
+func FieldFromMaybe[A any](m Maybe[A]) Field[A] {
+	if m.IsEmpty() {
+		return NullField[A]()
+	}
+
+	return FieldOf(m.Get())
+}
+
+// NullField creates a new Field that is explicitly set to null.
+func NullField[A any]() Field[A] {
+	return Field[A]{
+		state: fieldNull,
+	}
+}
+
+// UnsetField creates a new Field that is unset.
+func UnsetField[A any]() Field[A] {
+	return Field[A]{}
+}
+
+// Get returns the value of type A contained in the Field. If this function is invoked on a Field
+// that is unset or null then it will cause a panic.
+func (f Field[A]) Get() A {
+	if f.state != fieldPresent {
+		panic("Get() called on a Field with no value")
+	}
+
+	return f.value
+}
+
+// IsNull returns true if the Field is explicitly set to null and false otherwise.
+func (f Field[A]) IsNull() bool {
+	return f.state == fieldNull
+}
+
+// IsPresent returns true if the Field is set to a value and false otherwise.
+func (f Field[A]) IsPresent() bool {
+	return f.state == fieldPresent
+}
+
+// IsUnset returns true if the Field is unset and false otherwise.
+func (f Field[A]) IsUnset() bool {
+	return f.state == fieldUnset
+}
+
+// IsZero returns true if the Field is unset. It is used by the encoding/json omitzero option.
+func (f Field[A]) IsZero() bool {
+	return f.IsUnset()
+}
+
+// Or returns the value contained in the Field if it is set to a value, otherwise it returns the
+// specified value.
+func (f Field[A]) Or(value A) A {
+	if f.state == fieldPresent {
+		return f.value
+	}
+
+	return value
+}
+
+// ToMaybe returns a Maybe containing the value of the Field if it is set to a value, otherwise
+// an empty Maybe is returned.
+func (f Field[A]) ToMaybe() Maybe[A] {
+	if f.state == fieldPresent {
+		return Just(f.value)
+	}
+
+	return Nothing[A]()
+}
+
+// ApplyTo updates the target according to the Field. If the Field is unset then the target is
+// left as is, if it is null then the target is set to the zero value of A and otherwise the target
+// is set to the value of the Field.
+func (f Field[A]) ApplyTo(target *A) {
+	switch {
+	case f.IsPresent():
+		*target = f.Get()
+	case f.IsNull():
+		*target = *new(A)
+	}
+}
+
+// ApplyToMaybe updates the target according to the Field. If the Field is unset then the target
+// is left as is, if it is null then the target is set to an empty Maybe and otherwise the target
+// is set to a Maybe containing the value of the Field.
+func (f Field[A]) ApplyToMaybe(target *Maybe[A]) {
+	if !f.IsUnset() {
+		*target = f.ToMaybe()
+	}
+}
+
+// ApplyToPointer updates the target according to the Field. If the Field is unset then the target
+// is left as is, if it is null then the target is set to nil and otherwise the target is set to
+// point to a copy of the value of the Field.
+func (f Field[A]) ApplyToPointer(target **A) {
+	switch {
+	case f.IsPresent():
+		value := f.Get()
+		*target = &value
+	case f.IsNull():
+		*target = nil
+	}
+}
+
+// String returns a string representation of the Field.
+func (f Field[A]) String() string {
+	switch {
+	case f.IsPresent():
+		return fmt.Sprintf("Field(%v)", f.Get())
+	case f.IsNull():
+		return "Field(null)"
+	default:
+		return "Field(unset)"
+	}
+}
+
+// MarshalJSON converts the value in the Field, if set to a value, to it's JSON representation. If
+// the Field is null or unset then the JSON representation is null. Use the omitzero option to omit
+// an unset Field from the JSON representation of a struct.
+func (f Field[A]) MarshalJSON() ([]byte, error) {
+	if f.state != fieldPresent {
+		return []byte("null"), nil
+	}
+
+	bytes, err := json.Marshal(f.value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Field value to JSON: %w", err)
+	}
+
+	return bytes, nil
+}
+
+// UnmarshalJSON converts the JSON bytes to the value contained in the Field. JSON null results in
+// a null Field. A Field whose key is missing from a JSON object is never unmarshalled into, so it
+// remains unset.
+func (f *Field[A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = NullField[A]()
+		return nil
+	}
+
+	var value A
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return fmt.Errorf("unmarshal Field value from JSON: %w", err)
+	}
+
+	*f = FieldOf(value)
+
+	return nil
+}
+
+// ApplyPatch updates the target struct using the Fields of the patch struct. Each exported Field
+// of the patch struct is applied to the exported field of the target struct with the same name,
+// whose type must be A, *A, Maybe[A] or Field[A]. A Field is applied to a field of type A as by
+// ApplyTo, to a field of type *A as by ApplyToPointer and to a field of type Maybe[A] as by
+// ApplyToMaybe. Fields of the patch struct of any other type are ignored. Returns an error if the
+// target has no matching field or it is of an incompatible type.
+func ApplyPatch[T, P any](target *T, patch P) error {
+	tv := reflect.ValueOf(target).Elem()
+	pv := reflect.ValueOf(patch)
+
+	if tv.Kind() != reflect.Struct || pv.Kind() != reflect.Struct {
+		return fmt.Errorf("apply patch: %s and %s must both be struct types", tv.Type(), pv.Type())
+	}
+
+	for i := range pv.NumField() {
+		sf := pv.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field, ok := pv.Field(i).Interface().(patchField)
+		if !ok {
+			continue
+		}
+
+		dst := tv.FieldByName(sf.Name)
+		if !dst.IsValid() || !dst.CanSet() {
+			return fmt.Errorf("apply patch: %s has no exported field %s", tv.Type(), sf.Name)
+		}
+
+		if err := field.applyTo(dst); err != nil {
+			return fmt.Errorf("apply patch to field %s: %w", sf.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// patchField is implemented by every Field so that ApplyPatch can apply one without knowing the
+// type of its value.
+type patchField interface {
+	applyTo(dst reflect.Value) error
+}
+
+func (f Field[A]) applyTo(dst reflect.Value) error {
+	switch dst.Type() {
+	case reflect.TypeFor[A]():
+		f.ApplyTo(dst.Addr().Interface().(*A))
+	case reflect.TypeFor[*A]():
+		f.ApplyToPointer(dst.Addr().Interface().(**A))
+	case reflect.TypeFor[Maybe[A]]():
+		f.ApplyToMaybe(dst.Addr().Interface().(*Maybe[A]))
+	case reflect.TypeFor[Field[A]]():
+		if !f.IsUnset() {
+			dst.Set(reflect.ValueOf(f))
+		}
+	default:
+		return fmt.Errorf("cannot apply Field of %s to %s", reflect.TypeFor[A](), dst.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/maybe"
+)
+
+type user struct {
+	Name     string
+	Email    *string
+	Nickname maybe.Maybe[string]
+	Age      int
+}
+
+type userPatch struct {
+	Name     maybe.Field[string] `json:"name,omitzero"`
+	Email    maybe.Field[string] `json:"email,omitzero"`
+	Nickname maybe.Field[string] `json:"nickname,omitzero"`
+	Age      maybe.Field[int]    `json:"age,omitzero"`
+	Comment  string              `json:"comment,omitzero"`
+}
+
+func Test_Field_States(t *testing.T) {
+	tests := map[string]struct {
+		field   maybe.Field[int]
+		unset   bool
+		null    bool
+		present bool
+		or      int
+		maybe   maybe.Maybe[int]
+		str     string
+	}{
+		"zero value": {field: maybe.Field[int]{}, unset: true, or: -1, maybe: maybe.Nothing[int](), str: "Field(unset)"},
+		"unset":      {field: maybe.UnsetField[int](), unset: true, or: -1, maybe: maybe.Nothing[int](), str: "Field(unset)"},
+		"null":       {field: maybe.NullField[int](), null: true, or: -1, maybe: maybe.Nothing[int](), str: "Field(null)"},
+		"value":      {field: maybe.FieldOf(1), present: true, or: 1, maybe: maybe.Just(1), str: "Field(1)"},
+		"zero":       {field: maybe.FieldOf(0), present: true, or: 0, maybe: maybe.Just(0), str: "Field(0)"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.unset, test.field.IsUnset())
+			assert.Equal(t, test.unset, test.field.IsZero())
+			assert.Equal(t, test.null, test.field.IsNull())
+			assert.Equal(t, test.present, test.field.IsPresent())
+			assert.Equal(t, test.or, test.field.Or(-1))
+			assert.Equal(t, test.maybe, test.field.ToMaybe())
+			assert.Equal(t, test.str, test.field.String())
+
+			if test.present {
+				assert.Equal(t, test.or, test.field.Get())
+			} else {
+				assert.Panics(t, func() { test.field.Get() })
+			}
+		})
+	}
+}
+
+func Test_Field_Comparable(t *testing.T) {
+	assert.True(t, maybe.FieldOf(1) == maybe.FieldOf(1))
+	assert.True(t, maybe.NullField[int]() == maybe.NullField[int]())
+	assert.True(t, maybe.UnsetField[int]() == maybe.Field[int]{})
+	assert.False(t, maybe.FieldOf(0) == maybe.NullField[int]())
+	assert.False(t, maybe.NullField[int]() == maybe.UnsetField[int]())
+
+	p := userPatch{Name: maybe.FieldOf("a")}
+
+	assert.True(t, p == userPatch{Name: maybe.FieldOf("a")})
+}
+
+func Test_FieldFromMaybe(t *testing.T) {
+	assert.Equal(t, maybe.FieldOf(1), maybe.FieldFromMaybe(maybe.Just(1)))
+	assert.Equal(t, maybe.NullField[int](), maybe.FieldFromMaybe(maybe.Nothing[int]()))
+}
+
+func Test_Field_MarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		patch  userPatch
+		expect string
+	}{
+		"unset omitted": {patch: userPatch{}, expect: `{}`},
+		"null":          {patch: userPatch{Name: maybe.NullField[string](), Email: maybe.NullField[string]()}, expect: `{"name":null,"email":null}`},
+		"values":        {patch: userPatch{Name: maybe.FieldOf("a"), Age: maybe.FieldOf(0)}, expect: `{"name":"a","age":0}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := json.Marshal(test.patch)
+
+			assert.Nil(t, err)
+			assert.JSONEq(t, test.expect, string(res))
+		})
+	}
+
+	res, err := maybe.UnsetField[int]().MarshalJSON()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "null", string(res))
+
+	_, err = maybe.FieldOf(func() {}).MarshalJSON()
+
+	assert.ErrorContains(t, err, "marshal Field value to JSON:")
+}
+
+func Test_Field_UnmarshalJSON(t *testing.T) {
+	var p userPatch
+
+	err := json.Unmarshal([]byte(`{"name":"a","email":null,"age":0}`), &p)
+
+	assert.Nil(t, err)
+	assert.Equal(t, maybe.FieldOf("a"), p.Name)
+	assert.True(t, p.Email.IsNull())
+	assert.True(t, p.Nickname.IsUnset())
+	assert.Equal(t, maybe.FieldOf(0), p.Age)
+
+	err = json.Unmarshal([]byte(`{"age":"x"}`), &p)
+
+	assert.ErrorContains(t, err, "unmarshal Field value from JSON:")
+}
+
+func Test_Field_Apply(t *testing.T) {
+	tests := map[string]struct {
+		field         maybe.Field[string]
+		value         string
+		pointer       *string
+		maybe         maybe.Maybe[string]
+		expectPointer bool
+	}{
+		"unset": {field: maybe.UnsetField[string](), value: "old", pointer: nil, maybe: maybe.Just("old"), expectPointer: true},
+		"null":  {field: maybe.NullField[string](), value: "", maybe: maybe.Nothing[string]()},
+		"value": {field: maybe.FieldOf("new"), value: "new", maybe: maybe.Just("new"), expectPointer: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value := "old"
+			pointer := &value
+			m := maybe.Just("old")
+
+			test.field.ApplyTo(&value)
+			test.field.ApplyToPointer(&pointer)
+			test.field.ApplyToMaybe(&m)
+
+			assert.Equal(t, test.value, value)
+			assert.Equal(t, test.maybe, m)
+
+			if test.expectPointer {
+				assert.Equal(t, test.maybe.Get(), *pointer)
+			} else {
+				assert.Nil(t, pointer)
+			}
+		})
+	}
+}
+
+func Test_ApplyPatch(t *testing.T) {
+	email := "old@example.com"
+
+	tests := map[string]struct {
+		patch  string
+		expect user
+	}{
+		"empty patch": {
+			patch:  `{}`,
+			expect: user{Name: "old", Email: &email, Nickname: maybe.Just("oldie"), Age: 30},
+		},
+		"set values": {
+			patch:  `{"name":"new","email":"new@example.com","nickname":"newbie","age":31,"comment":"ignored"}`,
+			expect: user{Name: "new", Email: ptr("new@example.com"), Nickname: maybe.Just("newbie"), Age: 31},
+		},
+		"null values": {
+			patch:  `{"name":null,"email":null,"nickname":null}`,
+			expect: user{Name: "", Email: nil, Nickname: maybe.Nothing[string](), Age: 30},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := user{Name: "old", Email: &email, Nickname: maybe.Just("oldie"), Age: 30}
+
+			var p userPatch
+
+			assert.Nil(t, json.Unmarshal([]byte(test.patch), &p))
+			assert.Nil(t, maybe.ApplyPatch(&target, p))
+			assert.Equal(t, test.expect, target)
+		})
+	}
+}
+
+func Test_ApplyPatch_FieldTarget(t *testing.T) {
+	target := userPatch{Name: maybe.FieldOf("old"), Age: maybe.FieldOf(1)}
+
+	err := maybe.ApplyPatch(&target, userPatch{Name: maybe.NullField[string]()})
+
+	assert.Nil(t, err)
+	assert.True(t, target.Name.IsNull())
+	assert.Equal(t, maybe.FieldOf(1), target.Age)
+}
+
+func Test_ApplyPatch_Invalid(t *testing.T) {
+	type mismatched struct {
+		Name int
+	}
+
+	type missing struct{}
+
+	patch := userPatch{Name: maybe.FieldOf("a")}
+
+	assert.ErrorContains(t, maybe.ApplyPatch(&mismatched{}, patch), "apply patch to field Name: cannot apply Field of string to int")
+	assert.ErrorContains(t, maybe.ApplyPatch(&missing{}, patch), "has no exported field Name")
+
+	n := 1
+
+	assert.ErrorContains(t, maybe.ApplyPatch(&n, patch), "must both be struct types")
+}
+
+func ptr[A any](a A) *A {
+	return &a
+}
@@ -3,6 +3,7 @@ package maybe
 import (
 	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/dustin10/itrz/fn"
 )
@@ -14,6 +15,12 @@ type Maybe[A any] struct {
 	present bool
 }
 
+// Pair holds the two values combined by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
 // Just creates a new Maybe with a value of type A present.
 func Just[A any](value A) Maybe[A] {
 	return Maybe[A]{
@@ -39,6 +46,24 @@ func FromPointer[A any](p *A) Maybe[A] {
 	return Just(*p)
 }
 
+// FromMapLookup creates a new Maybe containing the value stored under the specified key in the map,
+// or an empty Maybe if the map does not contain the key.
+func FromMapLookup[M ~map[K]V, K comparable, V any](m M, key K) Maybe[V] {
+	v, ok := m[key]
+
+	return FromOk(v, ok)
+}
+
+// FromOk creates a new Maybe from the conventional (value, ok) return values of a function or
+// expression. If ok is true then the Maybe will contain the value, otherwise it will be empty.
+func FromOk[A any](value A, ok bool) Maybe[A] {
+	if !ok {
+		return Nothing[A]()
+	}
+
+	return Just(value)
+}
+
 // FromString creates a new Maybe of type string. If the string is empty then the Maybe will be
 // empty, otherwise the Maybe will contain the given string value.
 func FromString(s string) Maybe[string] {
@@ -49,6 +74,27 @@ func FromString(s string) Maybe[string] {
 	return Just(s)
 }
 
+// All returns an iter.Seq that yields the value contained in the Maybe if present, or nothing if
+// the Maybe is empty. An iter.Seq is returned rather than an itrz.Seq because itrz depends on this
+// package, use itrz.FromMaybe to obtain an itrz.Seq directly.
+func (m Maybe[A]) All() iter.Seq[A] {
+	return func(yield func(A) bool) {
+		if m.present {
+			yield(m.value)
+		}
+	}
+}
+
+// Expect returns the value of type A contained in the Maybe. If this function is invoked on an
+// empty Maybe then it will cause a panic with the specified message.
+func (m Maybe[A]) Expect(msg string) A {
+	if !m.present {
+		panic(msg)
+	}
+
+	return m.value
+}
+
 // Filter applies the given Predicate to the value contained in the Maybe. If there is no value
 // present, then the function is not applied and an empty Maybe is returned.
 func (m Maybe[A]) Filter(p fn.Predicate[A]) Maybe[A] {
@@ -75,6 +121,23 @@ func (m Maybe[A]) Get() A {
 	return m.value
 }
 
+// IfPresent invokes the given Consumer with the value contained in the Maybe if it exists.
+func (m Maybe[A]) IfPresent(f fn.Consumer[A]) {
+	if m.present {
+		f(m.value)
+	}
+}
+
+// IfPresentOrElse invokes the given Consumer with the value contained in the Maybe if it exists,
+// otherwise it invokes the orElse function.
+func (m Maybe[A]) IfPresentOrElse(f fn.Consumer[A], orElse func()) {
+	if m.present {
+		f(m.value)
+	} else {
+		orElse()
+	}
+}
+
 // IsPresent returns true if the Maybe contains a value and false otherwise.
 func (m Maybe[A]) IsPresent() bool {
 	return m.present
@@ -105,6 +168,41 @@ func (m Maybe[A]) OrElse(f fn.Factory[A]) A {
 	return f()
 }
 
+// OrMaybe returns the Maybe if it contains a value, otherwise it returns the specified Maybe.
+// Calls can be chained to choose the first Maybe that contains a value.
+func (m Maybe[A]) OrMaybe(other Maybe[A]) Maybe[A] {
+	if m.present {
+		return m
+	}
+
+	return other
+}
+
+// ToPointer returns a pointer to a copy of the value contained in the Maybe if it exists, or nil
+// if the Maybe is empty.
+func (m Maybe[A]) ToPointer() *A {
+	if !m.present {
+		return nil
+	}
+
+	value := m.value
+
+	return &value
+}
+
+// Xor returns whichever of the Maybe and the specified Maybe contains a value if exactly one of
+// them does, otherwise it returns an empty Maybe.
+func (m Maybe[A]) Xor(other Maybe[A]) Maybe[A] {
+	switch {
+	case m.present && !other.present:
+		return m
+	case !m.present && other.present:
+		return other
+	default:
+		return Nothing[A]()
+	}
+}
+
 // String returns a string representation of the Maybe.
 func (m Maybe[A]) String() string {
 	if m.present {
@@ -141,6 +239,16 @@ func (m *Maybe[_]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Apply applies the Function contained in the first Maybe to the value contained in the second
+// Maybe if both exist, otherwise it returns an empty Maybe.
+func Apply[A, B any](mf Maybe[fn.Function[A, B]], m Maybe[A]) Maybe[B] {
+	if mf.IsEmpty() {
+		return Nothing[B]()
+	}
+
+	return Map(m, mf.Get())
+}
+
 // FlatMap applies the given Function to the value in the Maybe if it exists.
 func FlatMap[A, B any](m Maybe[A], f fn.Function[A, Maybe[B]]) Maybe[B] {
 	if m.IsEmpty() {
@@ -150,6 +258,27 @@ func FlatMap[A, B any](m Maybe[A], f fn.Function[A, Maybe[B]]) Maybe[B] {
 	return f(m.Get())
 }
 
+// Fold returns the result of applying the onJust Function to the value in the Maybe if it exists,
+// otherwise it returns the value returned by the onNothing Factory.
+func Fold[A, B any](m Maybe[A], onNothing fn.Factory[B], onJust fn.Function[A, B]) B {
+	if m.IsEmpty() {
+		return onNothing()
+	}
+
+	return onJust(m.Get())
+}
+
+// FromZero creates a new Maybe of type A. If the value is the zero value of A then the Maybe will
+// be empty, otherwise the Maybe will contain the given value.
+func FromZero[A comparable](value A) Maybe[A] {
+	var zero A
+	if value == zero {
+		return Nothing[A]()
+	}
+
+	return Just(value)
+}
+
 // Map applies the given Function to the value in the Maybe if it exists.
 func Map[A, B any](m Maybe[A], f fn.Function[A, B]) Maybe[B] {
 	if m.IsEmpty() {
@@ -158,3 +287,21 @@ func Map[A, B any](m Maybe[A], f fn.Function[A, B]) Maybe[B] {
 
 	return Just(f(m.Get()))
 }
+
+// Zip combines the values in the two Maybe instances into a Maybe containing a Pair if both exist,
+// otherwise it returns an empty Maybe.
+func Zip[A, B any](ma Maybe[A], mb Maybe[B]) Maybe[Pair[A, B]] {
+	return ZipWith(ma, mb, func(a A, b B) Pair[A, B] {
+		return Pair[A, B]{First: a, Second: b}
+	})
+}
+
+// ZipWith applies the given Function to the values in the two Maybe instances if both exist,
+// otherwise it returns an empty Maybe.
+func ZipWith[A, B, C any](ma Maybe[A], mb Maybe[B], f fn.Function2[A, B, C]) Maybe[C] {
+	if ma.IsEmpty() || mb.IsEmpty() {
+		return Nothing[C]()
+	}
+
+	return Just(f(ma.Get(), mb.Get()))
+}
@@ -1,6 +1,7 @@
 package maybe_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,27 @@ func Test_FromString(t *testing.T) {
 	}
 }
 
+func Test_All(t *testing.T) {
+	tests := map[string]struct {
+		value  maybe.Maybe[int]
+		expect []int
+	}{
+		"Just":    {value: maybe.Just(1), expect: []int{1}},
+		"Nothing": {value: maybe.Nothing[int](), expect: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := make([]int, 0)
+			for n := range test.value.All() {
+				res = append(res, n)
+			}
+
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
 func Test_Filter(t *testing.T) {
 	tests := map[string]struct {
 		value     any
@@ -288,3 +310,177 @@ func fail(any) bool {
 func strlen(s string) int {
 	return len(s)
 }
+
+func Test_FromOk(t *testing.T) {
+	assert.Equal(t, maybe.Just(1), maybe.FromOk(1, true))
+	assert.Equal(t, maybe.Nothing[int](), maybe.FromOk(1, false))
+}
+
+func Test_FromZero(t *testing.T) {
+	tests := map[string]struct {
+		value  int
+		expect maybe.Maybe[int]
+	}{
+		"zero":     {value: 0, expect: maybe.Nothing[int]()},
+		"non-zero": {value: 5, expect: maybe.Just(5)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, maybe.FromZero(test.value))
+		})
+	}
+}
+
+func Test_FromMapLookup(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+
+	tests := map[string]struct {
+		key    string
+		expect maybe.Maybe[int]
+	}{
+		"present": {key: "a", expect: maybe.Just(1)},
+		"zero":    {key: "zero", expect: maybe.Just(0)},
+		"missing": {key: "b", expect: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, maybe.FromMapLookup(m, test.key))
+		})
+	}
+}
+
+func Test_ToPointer(t *testing.T) {
+	p := maybe.Just(1).ToPointer()
+
+	assert.NotNil(t, p)
+	assert.Equal(t, 1, *p)
+	assert.Nil(t, maybe.Nothing[int]().ToPointer())
+}
+
+func Test_Expect(t *testing.T) {
+	assert.Equal(t, 1, maybe.Just(1).Expect("missing"))
+	assert.PanicsWithValue(t, "missing", func() { maybe.Nothing[int]().Expect("missing") })
+}
+
+func Test_IfPresent(t *testing.T) {
+	tests := map[string]struct {
+		value  maybe.Maybe[int]
+		expect []string
+	}{
+		"Just":    {value: maybe.Just(1), expect: []string{"present 1"}},
+		"Nothing": {value: maybe.Nothing[int](), expect: []string{"else"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := make([]string, 0)
+
+			present := func(n int) { calls = append(calls, "present "+strconv.Itoa(n)) }
+
+			test.value.IfPresentOrElse(present, func() { calls = append(calls, "else") })
+
+			assert.Equal(t, test.expect, calls)
+
+			calls = calls[:0]
+
+			test.value.IfPresent(present)
+
+			assert.Equal(t, test.value.IsPresent(), len(calls) == 1)
+		})
+	}
+}
+
+func Test_Xor(t *testing.T) {
+	tests := map[string]struct {
+		m      maybe.Maybe[int]
+		other  maybe.Maybe[int]
+		expect maybe.Maybe[int]
+	}{
+		"both Just":    {m: maybe.Just(1), other: maybe.Just(2), expect: maybe.Nothing[int]()},
+		"first Just":   {m: maybe.Just(1), other: maybe.Nothing[int](), expect: maybe.Just(1)},
+		"second Just":  {m: maybe.Nothing[int](), other: maybe.Just(2), expect: maybe.Just(2)},
+		"both Nothing": {m: maybe.Nothing[int](), other: maybe.Nothing[int](), expect: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.m.Xor(test.other))
+		})
+	}
+}
+
+func Test_OrMaybe(t *testing.T) {
+	tests := map[string]struct {
+		m      maybe.Maybe[int]
+		other  maybe.Maybe[int]
+		expect maybe.Maybe[int]
+	}{
+		"both Just":    {m: maybe.Just(1), other: maybe.Just(2), expect: maybe.Just(1)},
+		"first Just":   {m: maybe.Just(1), other: maybe.Nothing[int](), expect: maybe.Just(1)},
+		"second Just":  {m: maybe.Nothing[int](), other: maybe.Just(2), expect: maybe.Just(2)},
+		"both Nothing": {m: maybe.Nothing[int](), other: maybe.Nothing[int](), expect: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.m.OrMaybe(test.other))
+		})
+	}
+
+	chained := maybe.Nothing[int]().OrMaybe(maybe.Nothing[int]()).OrMaybe(maybe.Just(3))
+
+	assert.Equal(t, maybe.Just(3), chained)
+}
+
+func Test_Zip(t *testing.T) {
+	tests := map[string]struct {
+		a      maybe.Maybe[int]
+		b      maybe.Maybe[string]
+		expect maybe.Maybe[maybe.Pair[int, string]]
+	}{
+		"both Just":     {a: maybe.Just(1), b: maybe.Just("a"), expect: maybe.Just(maybe.Pair[int, string]{First: 1, Second: "a"})},
+		"first Nothing": {a: maybe.Nothing[int](), b: maybe.Just("a"), expect: maybe.Nothing[maybe.Pair[int, string]]()},
+		"last Nothing":  {a: maybe.Just(1), b: maybe.Nothing[string](), expect: maybe.Nothing[maybe.Pair[int, string]]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, maybe.Zip(test.a, test.b))
+		})
+	}
+}
+
+func Test_ZipWith(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	assert.Equal(t, maybe.Just(3), maybe.ZipWith(maybe.Just(1), maybe.Just(2), add))
+	assert.True(t, maybe.ZipWith(maybe.Just(1), maybe.Nothing[int](), add).IsEmpty())
+	assert.True(t, maybe.ZipWith(maybe.Nothing[int](), maybe.Just(2), add).IsEmpty())
+}
+
+func Test_Apply(t *testing.T) {
+	tests := map[string]struct {
+		f      maybe.Maybe[fn.Function[string, int]]
+		value  maybe.Maybe[string]
+		expect maybe.Maybe[int]
+	}{
+		"both Just":        {f: maybe.Just[fn.Function[string, int]](strlen), value: maybe.Just("abc"), expect: maybe.Just(3)},
+		"Function Nothing": {f: maybe.Nothing[fn.Function[string, int]](), value: maybe.Just("abc"), expect: maybe.Nothing[int]()},
+		"value Nothing":    {f: maybe.Just[fn.Function[string, int]](strlen), value: maybe.Nothing[string](), expect: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, maybe.Apply(test.f, test.value))
+		})
+	}
+}
+
+func Test_Fold(t *testing.T) {
+	onNothing := func() string { return "none" }
+
+	assert.Equal(t, "3", maybe.Fold(maybe.Just(3), onNothing, strconv.Itoa))
+	assert.Equal(t, "none", maybe.Fold(maybe.Nothing[int](), onNothing, strconv.Itoa))
+}
@@ -0,0 +1,54 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements the sql.Scanner interface so that a Maybe can be used as a destination when
+// scanning a row returned by a database/sql query. A NULL value results in an empty Maybe, any
+// other value is converted to type A using the same rules as the database/sql package uses when
+// scanning into a value of type A directly, including delegating to A if it implements
+// sql.Scanner itself.
+func (m *Maybe[A]) Scan(src any) error {
+	var n sql.Null[A]
+
+	if err := n.Scan(src); err != nil {
+		return fmt.Errorf("scan Maybe value: %w", err)
+	}
+
+	m.value = n.V
+	m.present = n.Valid
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface so that a Maybe can be used as an argument of a
+// database/sql query. An empty Maybe is converted to NULL. A value that implements
+// driver.Valuer is converted using it, any other value is converted using
+// driver.DefaultParameterConverter, e.g. integer types are converted to int64 and types derived
+// from string are converted to string.
+func (m Maybe[A]) Value() (driver.Value, error) {
+	if !m.present {
+		return nil, nil
+	}
+
+	var v any = m.value
+
+	if valuer, ok := v.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return nil, fmt.Errorf("convert Maybe value to driver value: %w", err)
+		}
+
+		v = value
+	}
+
+	value, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("convert Maybe value to driver value: %w", err)
+	}
+
+	return value, nil
+}
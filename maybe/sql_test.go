@@ -0,0 +1,277 @@
+package maybe_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/maybe"
+)
+
+type status string
+
+// point implements driver.Valuer and sql.Scanner using a textual representation.
+type point struct {
+	x, y string
+}
+
+func (p point) Value() (driver.Value, error) {
+	return p.x + "," + p.y, nil
+}
+
+func (p *point) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("point must be scanned from a string")
+	}
+
+	p.x, p.y, _ = strings.Cut(s, ",")
+
+	return nil
+}
+
+func Test_Value(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		value  driver.Valuer
+		expect driver.Value
+	}{
+		"Nothing":        {value: maybe.Nothing[int](), expect: nil},
+		"int":            {value: maybe.Just(1), expect: int64(1)},
+		"int32":          {value: maybe.Just(int32(2)), expect: int64(2)},
+		"uint8":          {value: maybe.Just(uint8(3)), expect: int64(3)},
+		"float32":        {value: maybe.Just(float32(1.5)), expect: float64(1.5)},
+		"bool":           {value: maybe.Just(true), expect: true},
+		"string":         {value: maybe.Just("value"), expect: "value"},
+		"derived string": {value: maybe.Just(status("active")), expect: "active"},
+		"bytes":          {value: maybe.Just([]byte("bytes")), expect: []byte("bytes")},
+		"time":           {value: maybe.Just(now), expect: now},
+		"Valuer":         {value: maybe.Just(point{"1", "2"}), expect: "1,2"},
+		"pointer":        {value: maybe.Just(new(int)), expect: int64(0)},
+		"nil pointer":    {value: maybe.Just[*int](nil), expect: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := test.value.Value()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_Value_Unsupported(t *testing.T) {
+	_, err := maybe.Just(struct{}{}).Value()
+
+	assert.ErrorContains(t, err, "convert Maybe value to driver value:")
+}
+
+func Test_Scan(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		src    any
+		scan   func(src any) (any, error)
+		expect any
+	}{
+		"NULL":             {src: nil, scan: scanAs[int], expect: maybe.Nothing[int]()},
+		"int64 to int":     {src: int64(1), scan: scanAs[int], expect: maybe.Just(1)},
+		"bytes to int":     {src: []byte("12"), scan: scanAs[int], expect: maybe.Just(12)},
+		"string to int":    {src: "13", scan: scanAs[int], expect: maybe.Just(13)},
+		"int64 to string":  {src: int64(7), scan: scanAs[string], expect: maybe.Just("7")},
+		"bytes to string":  {src: []byte("value"), scan: scanAs[string], expect: maybe.Just("value")},
+		"float64 to float": {src: 1.5, scan: scanAs[float32], expect: maybe.Just(float32(1.5))},
+		"int64 to bool":    {src: int64(1), scan: scanAs[bool], expect: maybe.Just(true)},
+		"derived string":   {src: "active", scan: scanAs[status], expect: maybe.Just(status("active"))},
+		"time":             {src: now, scan: scanAs[time.Time], expect: maybe.Just(now)},
+		"Scanner":          {src: "1,2", scan: scanAs[point], expect: maybe.Just(point{"1", "2"})},
+		"any":              {src: int64(1), scan: scanAs[any], expect: maybe.Just[any](int64(1))},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := test.scan(test.src)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_Scan_NullResetsValue(t *testing.T) {
+	m := maybe.Just(1)
+
+	err := m.Scan(nil)
+
+	assert.Nil(t, err)
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_Scan_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		src  any
+		scan func(src any) (any, error)
+	}{
+		"not a number":   {src: "x", scan: scanAs[int]},
+		"overflow":       {src: int64(300), scan: scanAs[uint8]},
+		"Scanner failed": {src: int64(1), scan: scanAs[point]},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := test.scan(test.src)
+
+			assert.ErrorContains(t, err, "scan Maybe value:")
+		})
+	}
+}
+
+func Test_SQLRoundTrip(t *testing.T) {
+	db := sql.OpenDB(&fakeConnector{})
+	defer db.Close()
+
+	for _, m := range []maybe.Maybe[int]{maybe.Just(1), maybe.Nothing[int](), maybe.Just(3)} {
+		_, err := db.Exec("INSERT", m, maybe.Map(m, func(n int) status { return status(strings.Repeat("*", n)) }))
+		assert.Nil(t, err)
+	}
+
+	rows, err := db.Query("SELECT")
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	defer rows.Close()
+
+	ns := make([]maybe.Maybe[int], 0)
+	ss := make([]maybe.Maybe[string], 0)
+
+	for rows.Next() {
+		var n maybe.Maybe[int]
+		var s maybe.Maybe[string]
+
+		assert.Nil(t, rows.Scan(&n, &s))
+
+		ns = append(ns, n)
+		ss = append(ss, s)
+	}
+
+	assert.Nil(t, rows.Err())
+	assert.Equal(t, []maybe.Maybe[int]{maybe.Just(1), maybe.Nothing[int](), maybe.Just(3)}, ns)
+	assert.Equal(t, []maybe.Maybe[string]{maybe.Just("*"), maybe.Nothing[string](), maybe.Just("***")}, ss)
+}
+
+// scanAs scans the source value into a Maybe of type A.
+func scanAs[A any](src any) (any, error) {
+	var m maybe.Maybe[A]
+	err := m.Scan(src)
+
+	return m, err
+}
+
+// fakeConnector is an in-process database/sql driver that stores the arguments of every INSERT
+// statement as a row and returns all of the stored rows for every SELECT statement. The values
+// are passed through unchanged, so the test observes exactly what database/sql hands the driver.
+type fakeConnector struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("use fakeConnector")
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{connector: c.connector, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	connector *fakeConnector
+	query     string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "INSERT" {
+		return nil, errors.New("unsupported statement")
+	}
+
+	s.connector.mu.Lock()
+	defer s.connector.mu.Unlock()
+
+	s.connector.rows = append(s.connector.rows, args)
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if s.query != "SELECT" {
+		return nil, errors.New("unsupported statement")
+	}
+
+	s.connector.mu.Lock()
+	defer s.connector.mu.Unlock()
+
+	return &fakeRows{rows: append([][]driver.Value(nil), s.connector.rows...)}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"n", "s"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package itrz_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/maybe"
+)
+
+func Test_CatMaybes(t *testing.T) {
+	tests := map[string]struct {
+		values   []maybe.Maybe[int]
+		expected []int
+	}{
+		"empty":       {values: nil, expected: []int{}},
+		"all Nothing": {values: []maybe.Maybe[int]{maybe.Nothing[int](), maybe.Nothing[int]()}, expected: []int{}},
+		"mixed":       {values: []maybe.Maybe[int]{maybe.Just(1), maybe.Nothing[int](), maybe.Just(3)}, expected: []int{1, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, consumeSeq(itrz.CatMaybes(itrz.All(test.values))))
+		})
+	}
+}
+
+func Test_FilterMap(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		expected []int
+	}{
+		"empty":   {values: nil, expected: []int{}},
+		"none":    {values: []string{"a", "b"}, expected: []int{}},
+		"some":    {values: []string{"1", "a", "3"}, expected: []int{1, 3}},
+		"all":     {values: []string{"1", "2"}, expected: []int{1, 2}},
+		"leading": {values: []string{"x", "x", "7"}, expected: []int{7}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, consumeSeq(itrz.FilterMap(itrz.All(test.values), atoi)))
+		})
+	}
+}
+
+func Test_FilterMap_EarlyTermination(t *testing.T) {
+	calls := 0
+
+	f := func(n int) maybe.Maybe[int] {
+		calls++
+		return evenMaybe(n)
+	}
+
+	res := itrz.FilterMap(itrz.Of(1, 2, 3, 4, 5, 6), f).Limit(2).ToSlice()
+
+	assert.Equal(t, []int{2, 4}, res)
+	assert.Equal(t, 4, calls)
+}
+
+func Test_FromMaybe(t *testing.T) {
+	assert.Equal(t, []int{1}, consumeSeq(itrz.FromMaybe(maybe.Just(1))))
+	assert.Equal(t, []int{}, consumeSeq(itrz.FromMaybe(maybe.Nothing[int]())))
+}
+
+func Test_Sequence(t *testing.T) {
+	tests := map[string]struct {
+		values   []maybe.Maybe[int]
+		expected maybe.Maybe[[]int]
+	}{
+		"empty":        {values: nil, expected: maybe.Just([]int{})},
+		"all Just":     {values: []maybe.Maybe[int]{maybe.Just(1), maybe.Just(2)}, expected: maybe.Just([]int{1, 2})},
+		"some Nothing": {values: []maybe.Maybe[int]{maybe.Just(1), maybe.Nothing[int](), maybe.Just(3)}, expected: maybe.Nothing[[]int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Sequence(itrz.All(test.values)))
+		})
+	}
+}
+
+func Test_Traverse(t *testing.T) {
+	tests := map[string]struct {
+		values   []string
+		expected maybe.Maybe[[]int]
+	}{
+		"empty":   {values: nil, expected: maybe.Just([]int{})},
+		"valid":   {values: []string{"1", "2"}, expected: maybe.Just([]int{1, 2})},
+		"invalid": {values: []string{"1", "x", "3"}, expected: maybe.Nothing[[]int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Traverse(itrz.All(test.values), atoi))
+		})
+	}
+}
+
+func Test_Traverse_ShortCircuits(t *testing.T) {
+	res := itrz.Traverse(itrz.GenerateWithLast(0, func(n int) int { return n + 1 }), func(n int) maybe.Maybe[int] {
+		if n == 3 {
+			return maybe.Nothing[int]()
+		}
+
+		return maybe.Just(n)
+	})
+
+	assert.True(t, res.IsEmpty())
+}
+
+func atoi(s string) maybe.Maybe[int] {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return maybe.Nothing[int]()
+	}
+
+	return maybe.Just(n)
+}
+
+func evenMaybe(n int) maybe.Maybe[int] {
+	if n%2 != 0 {
+		return maybe.Nothing[int]()
+	}
+
+	return maybe.Just(n)
+}
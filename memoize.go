@@ -0,0 +1,79 @@
+package itrz
+
+import (
+	"sync"
+)
+
+// Memoize returns a Seq that caches the elements of the specified Seq as they are produced so
+// that iterating the returned Seq again replays the cached elements instead of running the source
+// again. The source is only advanced when an iteration needs an element that has not been cached
+// yet, and it is iterated at most once in total. The returned Seq is safe to iterate from multiple
+// goroutines.
+//
+// Unlike the other operators, Memoize also returns a function that releases the source. The source
+// cannot be stopped when a consumer stops iterating early, because a later iteration may need the
+// elements that have not been cached yet, so it is suspended in a pull-style iterator, which holds
+// a goroutine until the source is exhausted or the returned function is called. The function must
+// therefore be called if the source may not be iterated to completion. Calling it stops the source
+// and leaves only the already cached elements to be replayed. It is safe to call the function more
+// than once.
+func Memoize[A any](seq Seq[A]) (Seq[A], func()) {
+	var mu sync.Mutex
+	var next func() (A, bool)
+	var stop func()
+
+	cache := make([]A, 0)
+	done := false
+
+	release := func() {
+		done = true
+		if stop != nil {
+			stop()
+		}
+	}
+
+	// get returns the element at the specified index, advancing the source if required.
+	get := func(idx int) (A, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if idx < len(cache) {
+			return cache[idx], true
+		}
+
+		var zero A
+		if done {
+			return zero, false
+		}
+
+		if next == nil {
+			next, stop = Pull(seq)
+		}
+
+		a, exists := next()
+		if !exists {
+			release()
+			return zero, false
+		}
+
+		cache = append(cache, a)
+
+		return a, true
+	}
+
+	memoized := func(yield func(A) bool) {
+		for idx := 0; ; idx++ {
+			a, exists := get(idx)
+			if !exists || !yield(a) {
+				return
+			}
+		}
+	}
+
+	return memoized, func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		release()
+	}
+}
@@ -0,0 +1,88 @@
+package itrz_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_Memoize(t *testing.T) {
+	calls := 0
+	source := itrz.Of(1, 2, 3).Peek(func(int) { calls = calls + 1 })
+
+	s, stop := itrz.Memoize(source)
+	defer stop()
+
+	assert.Equal(t, []int{1, 2, 3}, s.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, s.ToSlice())
+	assert.Equal(t, 3, calls)
+}
+
+func Test_Memoize_Lazy(t *testing.T) {
+	calls := 0
+	source := itrz.Generate(func() int {
+		calls = calls + 1
+		return calls
+	})
+
+	s, stop := itrz.Memoize(source)
+	defer stop()
+
+	assert.Equal(t, []int{1, 2}, take(s, 2))
+	assert.Equal(t, 2, calls)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, take(s, 4))
+	assert.Equal(t, 4, calls)
+
+	assert.Equal(t, []int{1}, take(s, 1))
+	assert.Equal(t, 4, calls)
+}
+
+func Test_Memoize_Stop(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	s, stop := itrz.Memoize(itrz.Generate(func() int { return 1 }))
+
+	assert.Equal(t, 3, len(take(s, 3)))
+
+	stop()
+	stop()
+
+	assert.Equal(t, 3, s.Count())
+	assertNoGoroutineLeak(t, before)
+}
+
+func Test_Memoize_Concurrent(t *testing.T) {
+	s, stop := itrz.Memoize(itrz.Of(1, 2, 3, 4, 5))
+	defer stop()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToSlice())
+		}()
+	}
+
+	wg.Wait()
+}
+
+// take returns the first n elements of the Seq, stopping the iteration as soon as the nth one
+// has been received.
+func take[A any](s itrz.Seq[A], n int) []A {
+	res := make([]A, 0, n)
+	for a := range s {
+		res = append(res, a)
+		if len(res) == n {
+			break
+		}
+	}
+
+	return res
+}
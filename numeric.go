@@ -0,0 +1,291 @@
+package itrz
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/dustin10/itrz/maybe"
+)
+
+// Number is a constraint that permits any integer or floating point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Bin is a single bin of a histogram. It counts the elements that are greater than or equal to
+// Low and strictly less than High, except for the last bin of a histogram which also counts the
+// elements equal to High.
+type Bin[A Number] struct {
+	Low   A
+	High  A
+	Count int
+}
+
+// RunningStats accumulates the count, mean and variance of a stream of numbers in a single pass
+// using Welford's algorithm, which is numerically stable. The zero value is ready to use.
+type RunningStats struct {
+	count int
+	mean  float64
+	m2    float64
+}
+
+// Add includes the specified value in the RunningStats.
+func (s *RunningStats) Add(x float64) {
+	s.count = s.count + 1
+
+	delta := x - s.mean
+	s.mean = s.mean + delta/float64(s.count)
+	s.m2 = s.m2 + delta*(x-s.mean)
+}
+
+// Count returns the number of values included in the RunningStats.
+func (s RunningStats) Count() int {
+	return s.count
+}
+
+// Mean returns the arithmetic mean of the values included in the RunningStats, or zero if there
+// are none.
+func (s RunningStats) Mean() float64 {
+	return s.mean
+}
+
+// Variance returns the population variance of the values included in the RunningStats, or zero
+// if there are none.
+func (s RunningStats) Variance() float64 {
+	if s.count < 1 {
+		return 0
+	}
+
+	return s.m2 / float64(s.count)
+}
+
+// SampleVariance returns the sample variance of the values included in the RunningStats, or zero
+// if there are fewer than two.
+func (s RunningStats) SampleVariance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+
+	return s.m2 / float64(s.count-1)
+}
+
+// StdDev returns the population standard deviation of the values included in the RunningStats.
+func (s RunningStats) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of the values included in the RunningStats.
+func (s RunningStats) SampleStdDev() float64 {
+	return math.Sqrt(s.SampleVariance())
+}
+
+// String returns a string representation of the RunningStats.
+func (s RunningStats) String() string {
+	return fmt.Sprintf("RunningStats(count=%d, mean=%g, variance=%g)", s.count, s.Mean(), s.Variance())
+}
+
+// Average returns a maybe.Maybe containing the arithmetic mean of the elements yielded by the
+// Seq, or an empty one if the Seq has no elements.
+func Average[A Number](seq Seq[A]) maybe.Maybe[float64] {
+	stats := Stats(seq)
+	if stats.Count() == 0 {
+		return maybe.Nothing[float64]()
+	}
+
+	return maybe.Just(stats.Mean())
+}
+
+// Histogram counts the elements yielded by the Seq into the bins defined by the specified edges,
+// which must be in strictly ascending order. The n edges define n-1 bins, each of which spans the
+// interval between two consecutive edges. Elements that fall outside of the first and last edges
+// are not counted, nor is NaN. Panics if fewer than two edges are specified or they are not in
+// strictly ascending order, which includes any edge being NaN.
+func Histogram[A Number](seq Seq[A], edges ...A) []Bin[A] {
+	if len(edges) < 2 {
+		panic("at least two edges must be specified")
+	}
+
+	bins := make([]Bin[A], len(edges)-1)
+	for i := range bins {
+		// written so that NaN edges are rejected as well
+		if !(edges[i] < edges[i+1]) {
+			panic("edges must be in strictly ascending order")
+		}
+
+		bins[i] = Bin[A]{Low: edges[i], High: edges[i+1]}
+	}
+
+	last := len(bins) - 1
+
+	for a := range seq {
+		// written so that NaN is not counted either
+		if !(a >= edges[0] && a <= edges[last+1]) {
+			continue
+		}
+
+		idx, found := slices.BinarySearch(edges, a)
+		if !found {
+			idx = idx - 1
+		}
+
+		idx = min(idx, last)
+		bins[idx].Count = bins[idx].Count + 1
+	}
+
+	return bins
+}
+
+// Product returns the product of the elements yielded by the Seq, or one if the Seq has no
+// elements.
+func Product[A Number](seq Seq[A]) A {
+	var product A = 1
+	for a := range seq {
+		product = product * a
+	}
+
+	return product
+}
+
+// Range returns a Seq that yields the numbers from start up to, but not including, end separated
+// by step. The step may be negative, in which case the numbers count down from start to end.
+// Iteration stops rather than overflowing the type. For floating point numbers the number of
+// elements is computed up front, treating an end within a small relative tolerance of a multiple
+// of the step as exactly that multiple, and each element is computed by multiplying the step
+// rather than adding it repeatedly, so rounding errors neither accumulate nor add or drop the
+// last element. Panics if step is zero.
+func Range[A Number](start, end, step A) Seq[A] {
+	return numberRange(start, end, step, false)
+}
+
+// RangeInclusive returns a Seq that yields the numbers from start up to and including end
+// separated by step, in the same manner as Range. Panics if step is zero.
+func RangeInclusive[A Number](start, end, step A) Seq[A] {
+	return numberRange(start, end, step, true)
+}
+
+// Stats returns a RunningStats that has accumulated all of the elements yielded by the Seq.
+func Stats[A Number](seq Seq[A]) RunningStats {
+	var stats RunningStats
+	for a := range seq {
+		stats.Add(float64(a))
+	}
+
+	return stats
+}
+
+// StatsSeq returns a Seq2 that yields each element of the Seq along with a RunningStats that
+// has accumulated all of the elements yielded so far, including that element.
+func StatsSeq[A Number](seq Seq[A]) Seq2[A, RunningStats] {
+	return func(yield func(A, RunningStats) bool) {
+		var stats RunningStats
+		for a := range seq {
+			stats.Add(float64(a))
+
+			if !yield(a, stats) {
+				return
+			}
+		}
+	}
+}
+
+// Sum returns the sum of the elements yielded by the Seq, or zero if the Seq has no elements.
+func Sum[A Number](seq Seq[A]) A {
+	var sum A
+	for a := range seq {
+		sum = sum + a
+	}
+
+	return sum
+}
+
+// floatRangeTolerance defines the relative tolerance used when computing the number of elements
+// of a floating point range, so that an end that is a multiple of the step apart from start is
+// treated as such despite rounding errors.
+const floatRangeTolerance = 1e-9
+
+// numberRange returns a Seq that yields the numbers from start towards end separated by step.
+func numberRange[A Number](start, end, step A, inclusive bool) Seq[A] {
+	var zero A
+	if step == zero {
+		panic("step must not be zero")
+	}
+
+	if isFloat[A]() {
+		return floatRange(start, end, step, inclusive)
+	}
+
+	ascending := step > zero
+
+	within := func(a A) bool {
+		if inclusive && a == end {
+			return true
+		}
+
+		if ascending {
+			return a < end
+		}
+
+		return a > end
+	}
+
+	return func(yield func(A) bool) {
+		for a := start; within(a); a = a + step {
+			if !yield(a) {
+				return
+			}
+
+			// the next number wraps around
+			if (a+step > a) != ascending {
+				return
+			}
+		}
+	}
+}
+
+// floatRange returns a Seq that yields the floating point numbers from start towards end separated
+// by step. The number of elements is computed up front, with a small relative tolerance, and each
+// element is computed by multiplying the step, so that rounding errors neither accumulate nor
+// decide whether the end is reached.
+func floatRange[A Number](start, end, step A, inclusive bool) Seq[A] {
+	steps := (float64(end) - float64(start)) / float64(step)
+	tolerance := floatRangeTolerance * max(1, math.Abs(steps))
+
+	var n float64
+	switch {
+	case math.IsNaN(steps) || steps < -tolerance:
+		n = 0
+	case inclusive:
+		n = math.Floor(steps+tolerance) + 1
+	default:
+		n = math.Ceil(steps - tolerance)
+	}
+
+	return func(yield func(A) bool) {
+		prev := start
+		for i := float64(0); i < n; i++ {
+			a := start + A(i)*step
+
+			// the number is no different for a tiny step
+			if i > 0 && a == prev {
+				return
+			}
+
+			if !yield(a) {
+				return
+			}
+
+			prev = a
+		}
+	}
+}
+
+// isFloat returns true if A is a floating point type.
+func isFloat[A Number]() bool {
+	var half A = 1
+	half = half / 2
+
+	return half != 0
+}
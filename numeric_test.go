@@ -0,0 +1,201 @@
+package itrz_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/maybe"
+)
+
+func Test_Range(t *testing.T) {
+	tests := map[string]struct {
+		start, end, step int
+		expected         []int
+		inclusive        []int
+	}{
+		"ascending":         {start: 0, end: 5, step: 1, expected: []int{0, 1, 2, 3, 4}, inclusive: []int{0, 1, 2, 3, 4, 5}},
+		"step":              {start: 0, end: 10, step: 3, expected: []int{0, 3, 6, 9}, inclusive: []int{0, 3, 6, 9}},
+		"step reaching end": {start: 0, end: 9, step: 3, expected: []int{0, 3, 6}, inclusive: []int{0, 3, 6, 9}},
+		"descending":        {start: 5, end: 0, step: -2, expected: []int{5, 3, 1}, inclusive: []int{5, 3, 1}},
+		"descending to end": {start: 4, end: 0, step: -2, expected: []int{4, 2}, inclusive: []int{4, 2, 0}},
+		"empty":             {start: 5, end: 5, step: 1, expected: []int{}, inclusive: []int{5}},
+		"wrong direction":   {start: 0, end: 5, step: -1, expected: []int{}, inclusive: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Range(test.start, test.end, test.step).ToSlice())
+			assert.Equal(t, test.inclusive, itrz.RangeInclusive(test.start, test.end, test.step).ToSlice())
+		})
+	}
+}
+
+func Test_Range_Overflow(t *testing.T) {
+	assert.Equal(t, []uint8{250, 251, 252, 253, 254, 255}, itrz.RangeInclusive[uint8](250, 255, 1).ToSlice())
+	assert.Equal(t, []uint8{250, 253}, itrz.RangeInclusive[uint8](250, 255, 3).ToSlice())
+	assert.Equal(t, []int8{-126, -127, -128}, itrz.RangeInclusive[int8](-126, -128, -1).ToSlice())
+	assert.Len(t, itrz.RangeInclusive[int8](math.MinInt8, math.MaxInt8, 1).ToSlice(), 256)
+}
+
+func Test_Range_Float(t *testing.T) {
+	tests := map[string]struct {
+		seq      itrz.Seq[float64]
+		expected []float64
+	}{
+		"exact step":             {seq: itrz.RangeInclusive(0.0, 1.0, 0.25), expected: []float64{0, 0.25, 0.5, 0.75, 1}},
+		"descending":             {seq: itrz.Range(1.0, 0.0, -0.5), expected: []float64{1, 0.5}},
+		"inexact exclusive end":  {seq: itrz.Range(0.0, 0.9, 0.3), expected: []float64{0, 0.3, 0.6}},
+		"inexact inclusive end":  {seq: itrz.RangeInclusive(0.0, 0.7, 0.1), expected: []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7}},
+		"inexact inclusive end2": {seq: itrz.RangeInclusive(0.0, 0.3, 0.1), expected: []float64{0, 0.1, 0.2, 0.3}},
+		"partial step":           {seq: itrz.Range(0.0, 1.0, 0.3), expected: []float64{0, 0.3, 0.6, 0.9}},
+		"partial step inclusive": {seq: itrz.RangeInclusive(0.0, 1.0, 0.3), expected: []float64{0, 0.3, 0.6, 0.9}},
+		"empty":                  {seq: itrz.Range(1.0, 1.0, 0.1), expected: []float64{}},
+		"single inclusive":       {seq: itrz.RangeInclusive(1.0, 1.0, 0.1), expected: []float64{1}},
+		"wrong direction":        {seq: itrz.Range(0.0, 1.0, -0.1), expected: []float64{}},
+		"NaN":                    {seq: itrz.Range(0.0, math.NaN(), 0.1), expected: []float64{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := consumeSeq(test.seq)
+
+			assert.InDeltaSlice(t, test.expected, res, 1e-12)
+			assert.Len(t, res, len(test.expected))
+		})
+	}
+
+	res := itrz.Range(0.0, 1.0, 0.1).ToSlice()
+
+	assert.Len(t, res, 10)
+	assert.InDelta(t, 0.9, res[9], 1e-12)
+}
+
+func Test_Range_ZeroStep(t *testing.T) {
+	assert.Panics(t, func() { itrz.Range(0, 5, 0) })
+	assert.Panics(t, func() { itrz.RangeInclusive(0.0, 5.0, 0.0) })
+}
+
+func Test_SumProduct(t *testing.T) {
+	tests := map[string]struct {
+		values  []int
+		sum     int
+		product int
+	}{
+		"empty":    {values: []int{}, sum: 0, product: 1},
+		"single":   {values: []int{4}, sum: 4, product: 4},
+		"multiple": {values: []int{1, 2, 3, 4}, sum: 10, product: 24},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.sum, itrz.Sum(itrz.All(test.values)))
+			assert.Equal(t, test.product, itrz.Product(itrz.All(test.values)))
+		})
+	}
+}
+
+func Test_Average(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected maybe.Maybe[float64]
+	}{
+		"empty":    {values: []int{}, expected: maybe.Nothing[float64]()},
+		"single":   {values: []int{4}, expected: maybe.Just(4.0)},
+		"multiple": {values: []int{1, 2, 3, 4}, expected: maybe.Just(2.5)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.Average(itrz.All(test.values)))
+		})
+	}
+}
+
+func Test_Stats(t *testing.T) {
+	stats := itrz.Stats(itrz.Of(2, 4, 4, 4, 5, 5, 7, 9))
+
+	assert.Equal(t, 8, stats.Count())
+	assert.InDelta(t, 5.0, stats.Mean(), 1e-12)
+	assert.InDelta(t, 4.0, stats.Variance(), 1e-12)
+	assert.InDelta(t, 2.0, stats.StdDev(), 1e-12)
+	assert.InDelta(t, 32.0/7.0, stats.SampleVariance(), 1e-12)
+	assert.InDelta(t, math.Sqrt(32.0/7.0), stats.SampleStdDev(), 1e-12)
+	assert.Equal(t, "RunningStats(count=8, mean=5, variance=4)", stats.String())
+
+	var empty itrz.RunningStats
+
+	assert.Equal(t, 0, empty.Count())
+	assert.Zero(t, empty.Mean())
+	assert.Zero(t, empty.Variance())
+	assert.Zero(t, empty.SampleVariance())
+}
+
+func Test_Stats_NumericallyStable(t *testing.T) {
+	// a large offset causes catastrophic cancellation with the naive sum of squares formula
+	stats := itrz.Stats(itrz.Of(1e9+4, 1e9+7, 1e9+13, 1e9+16))
+
+	assert.InDelta(t, 1e9+10, stats.Mean(), 1e-6)
+	assert.InDelta(t, 30.0, stats.SampleVariance(), 1e-6)
+}
+
+func Test_StatsSeq(t *testing.T) {
+	means := make([]float64, 0)
+	for _, stats := range itrz.StatsSeq(itrz.Of(1, 3, 5)) {
+		means = append(means, stats.Mean())
+	}
+
+	assert.Equal(t, []float64{1, 2, 3}, means)
+}
+
+func Test_Histogram(t *testing.T) {
+	tests := map[string]struct {
+		values   []float64
+		edges    []float64
+		expected []int
+	}{
+		"empty":                {values: []float64{}, edges: []float64{0, 1, 2}, expected: []int{0, 0}},
+		"lower edges included": {values: []float64{0, 1, 1.5}, edges: []float64{0, 1, 2}, expected: []int{1, 2}},
+		"last edge included":   {values: []float64{2}, edges: []float64{0, 1, 2}, expected: []int{0, 1}},
+		"outside ignored":      {values: []float64{-1, 0.5, 3, math.NaN()}, edges: []float64{0, 1, 2}, expected: []int{1, 0}},
+		"uneven bins":          {values: []float64{1, 5, 20, 50, 99}, edges: []float64{0, 10, 100}, expected: []int{2, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			bins := itrz.Histogram(itrz.All(test.values), test.edges...)
+
+			counts := make([]int, len(bins))
+			for i, bin := range bins {
+				assert.Equal(t, test.edges[i], bin.Low)
+				assert.Equal(t, test.edges[i+1], bin.High)
+
+				counts[i] = bin.Count
+			}
+
+			assert.Equal(t, test.expected, counts)
+		})
+	}
+}
+
+func Test_Histogram_RangeEdges(t *testing.T) {
+	bins := itrz.Histogram(itrz.Range(0, 100, 7), itrz.RangeInclusive(0, 100, 25).ToSlice()...)
+
+	assert.Equal(t, []itrz.Bin[int]{
+		{Low: 0, High: 25, Count: 4},
+		{Low: 25, High: 50, Count: 4},
+		{Low: 50, High: 75, Count: 3},
+		{Low: 75, High: 100, Count: 4},
+	}, bins)
+}
+
+func Test_Histogram_InvalidEdges(t *testing.T) {
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1), 0) })
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1), 0, 2, 1) })
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1), 0, 0) })
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1.0), math.NaN(), 1) })
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1.0), 0, math.NaN()) })
+	assert.Panics(t, func() { itrz.Histogram(itrz.Of(1.0), 0, math.NaN(), 2) })
+}
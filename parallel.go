@@ -0,0 +1,145 @@
+package itrz
+
+import (
+	"runtime"
+
+	"github.com/dustin10/itrz/fn"
+)
+
+// ParallelOption defines a function that can be used to customize the configuration used
+// by ParallelMap.
+type ParallelOption func(config *ParallelConfig)
+
+// ParallelConfig contains the supported configuration of ParallelMap.
+type ParallelConfig struct {
+	// Workers defines the number of goroutines used to apply the mapping function.
+	Workers int
+	// Ordered defines whether the mapped elements are yielded in the same order as the
+	// elements of the source Seq.
+	Ordered bool
+}
+
+// WithWorkers is a ParallelOption that can be used to configure the number of worker
+// goroutines used to apply the mapping function.
+func WithWorkers(workers int) ParallelOption {
+	return func(config *ParallelConfig) {
+		config.Workers = workers
+	}
+}
+
+// WithOrdered is a ParallelOption that can be used to configure whether the mapped elements
+// are yielded in the order of the source Seq or in the order in which they are completed.
+func WithOrdered(ordered bool) ParallelOption {
+	return func(config *ParallelConfig) {
+		config.Ordered = ordered
+	}
+}
+
+// parallelJob is a unit of work handed to a ParallelMap worker.
+type parallelJob[A any] struct {
+	idx   int
+	value A
+}
+
+// ParallelMap returns a new Seq consisting of the results of applying the given fn.Function
+// to the elements of the existing Seq using a bounded pool of worker goroutines. By default
+// the number of workers is runtime.GOMAXPROCS(0) and the results are yielded in the same order
+// as the source Seq. The number of elements in flight at any time is bounded by the number of
+// workers. When the consumer stops iterating, all goroutines started by the Seq have exited
+// before the iteration returns. A panic raised by the source Seq or the fn.Function is
+// re-raised in the goroutine that is ranging over the returned Seq.
+func ParallelMap[A, B any](seq Seq[A], f fn.Function[A, B], opts ...ParallelOption) Seq[B] {
+	config := ParallelConfig{
+		Workers: runtime.GOMAXPROCS(0),
+		Ordered: true,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.Workers <= 0 {
+		panic("number of workers must be positive")
+	}
+
+	return func(yield func(B) bool) {
+		fan := newFanIn[B](config.Workers)
+		tokens := make(chan struct{}, config.Workers)
+		jobs := make(chan parallelJob[A])
+
+		fan.spawn(func() {
+			defer close(jobs)
+
+			idx := 0
+			for a := range seq {
+				select {
+				case tokens <- struct{}{}:
+				case <-fan.done:
+					return
+				}
+
+				select {
+				case jobs <- parallelJob[A]{idx: idx, value: a}:
+				case <-fan.done:
+					return
+				}
+
+				idx = idx + 1
+			}
+		})
+
+		for range config.Workers {
+			fan.spawn(func() {
+				for job := range jobs {
+					if !fan.send(job.idx, f(job.value)) {
+						return
+					}
+				}
+			})
+		}
+
+		fan.closeWhenDone()
+		defer fan.stop()
+
+		if config.Ordered {
+			yieldOrdered(fan.received(), tokens, yield)
+		} else {
+			yieldUnordered(fan.received(), tokens, yield)
+		}
+	}
+}
+
+func yieldUnordered[B any](results Seq2[int, B], tokens <-chan struct{}, yield func(B) bool) {
+	for _, b := range results {
+		<-tokens
+
+		if !yield(b) {
+			return
+		}
+	}
+}
+
+func yieldOrdered[B any](results Seq2[int, B], tokens <-chan struct{}, yield func(B) bool) {
+	pending := make(map[int]B)
+	next := 0
+
+	for idx, b := range results {
+		pending[idx] = b
+
+		for {
+			b, exists := pending[next]
+			if !exists {
+				break
+			}
+
+			delete(pending, next)
+			next = next + 1
+
+			<-tokens
+
+			if !yield(b) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package itrz_test
+
+import (
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_ParallelMap(t *testing.T) {
+	f := func(n int) int {
+		return 2 * n
+	}
+
+	tests := map[string]struct {
+		values   []int
+		opts     []itrz.ParallelOption
+		expected []int
+	}{
+		"empty":              {values: []int{}, expected: []int{}},
+		"nil":                {values: nil, expected: []int{}},
+		"non-empty":          {values: []int{1, 2, 3, 4, 5}, expected: []int{2, 4, 6, 8, 10}},
+		"single worker":      {values: []int{1, 2, 3}, opts: []itrz.ParallelOption{itrz.WithWorkers(1)}, expected: []int{2, 4, 6}},
+		"many workers":       {values: []int{1, 2, 3}, opts: []itrz.ParallelOption{itrz.WithWorkers(16)}, expected: []int{2, 4, 6}},
+		"unordered":          {values: []int{1, 2, 3, 4, 5}, opts: []itrz.ParallelOption{itrz.WithOrdered(false)}, expected: []int{2, 4, 6, 8, 10}},
+		"unordered 1 worker": {values: []int{1, 2, 3}, opts: []itrz.ParallelOption{itrz.WithOrdered(false), itrz.WithWorkers(1)}, expected: []int{2, 4, 6}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := consumeSeq(itrz.ParallelMap(itrz.All(test.values), f, test.opts...))
+
+			if len(test.opts) > 0 && !isOrdered(test.opts) {
+				slices.Sort(res)
+			}
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func Test_ParallelMap_PreservesOrder(t *testing.T) {
+	values := itrz.GenerateWithLast(-1, func(n int) int { return n + 1 }).Limit(100).ToSlice()
+
+	// later elements finish first to force out of order completion
+	f := func(n int) int {
+		time.Sleep(time.Duration(100-n) * 10 * time.Microsecond)
+		return n
+	}
+
+	res := consumeSeq(itrz.ParallelMap(itrz.All(values), f, itrz.WithWorkers(8)))
+
+	assert.Equal(t, values, res)
+}
+
+func Test_ParallelMap_BoundedWorkers(t *testing.T) {
+	var active, peak atomic.Int32
+
+	f := func(n int) int {
+		cur := active.Add(1)
+		defer active.Add(-1)
+
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+
+		return n
+	}
+
+	s := itrz.ParallelMap(itrz.All(make([]int, 50)), f, itrz.WithWorkers(3))
+
+	assert.Equal(t, 50, s.Count())
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func Test_ParallelMap_NoGoroutineLeak(t *testing.T) {
+	tests := map[string]struct {
+		opts []itrz.ParallelOption
+	}{
+		"ordered":   {opts: []itrz.ParallelOption{itrz.WithWorkers(4)}},
+		"unordered": {opts: []itrz.ParallelOption{itrz.WithWorkers(4), itrz.WithOrdered(false)}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			next := 0
+			source := itrz.Generate(func() int {
+				next = next + 1
+				return next
+			})
+
+			count := 0
+			for range itrz.ParallelMap(source, func(n int) int { return n }, test.opts...) {
+				count = count + 1
+				if count == 10 {
+					break
+				}
+			}
+
+			assert.Equal(t, 10, count)
+			assertNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func Test_ParallelMap_Panics(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	f := func(n int) int {
+		if n == 3 {
+			panic("boom")
+		}
+
+		return n
+	}
+
+	assert.PanicsWithValue(t, "boom", func() {
+		for range itrz.ParallelMap(itrz.Of(1, 2, 3, 4, 5), f, itrz.WithWorkers(2)) {
+		}
+	})
+
+	assertNoGoroutineLeak(t, before)
+}
+
+func Test_ParallelMap_InvalidWorkers(t *testing.T) {
+	assert.Panics(t, func() { itrz.ParallelMap(itrz.Of(1), func(n int) int { return n }, itrz.WithWorkers(0)) })
+}
+
+func Test_ParallelOptions(t *testing.T) {
+	cfg := itrz.ParallelConfig{}
+
+	itrz.WithWorkers(7)(&cfg)
+	itrz.WithOrdered(true)(&cfg)
+
+	assert.Equal(t, 7, cfg.Workers)
+	assert.True(t, cfg.Ordered)
+}
+
+func isOrdered(opts []itrz.ParallelOption) bool {
+	cfg := itrz.ParallelConfig{Ordered: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg.Ordered
+}
+
+func assertNoGoroutineLeak(t *testing.T, before int) {
+	t.Helper()
+
+	// goroutines may take a moment to be torn down by the runtime after they return
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
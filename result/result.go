@@ -0,0 +1,215 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dustin10/itrz/fn"
+	"github.com/dustin10/itrz/maybe"
+)
+
+// Result encapsulates the outcome of an operation that can fail. Either it contains a value or
+// it contains an error. This struct is similar to Either in Haskell or Result in Rust.
+type Result[A any] struct {
+	value A
+	err   error
+}
+
+// Ok creates a new Result with a value of type A.
+func Ok[A any](value A) Result[A] {
+	return Result[A]{
+		value: value,
+	}
+}
+
+// Err creates a new Result containing the specified error. If this function is invoked with a
+// nil error then it will cause a panic.
+func Err[A any](err error) Result[A] {
+	if err == nil {
+		panic("Err() called with a nil error")
+	}
+
+	return Result[A]{
+		err: err,
+	}
+}
+
+// From creates a new Result from the conventional (value, error) return values of a function.
+// If the error is nil then the Result will contain the value, otherwise it will contain the
+// error.
+func From[A any](value A, err error) Result[A] {
+	if err != nil {
+		return Err[A](err)
+	}
+
+	return Ok(value)
+}
+
+// FromMaybe creates a new Result from the specified maybe.Maybe. If the maybe.Maybe contains a
+// value then the Result will contain the value, otherwise it will contain the given error.
+func FromMaybe[A any](m maybe.Maybe[A], err error) Result[A] {
+	if m.IsEmpty() {
+		return Err[A](err)
+	}
+
+	return Ok(m.Get())
+}
+
+// Err returns the error contained in the Result, or nil if the Result contains a value.
+func (r Result[A]) Err() error {
+	return r.err
+}
+
+// Get returns the value and error contained in the Result in the conventional (value, error)
+// form.
+func (r Result[A]) Get() (A, error) {
+	return r.value, r.err
+}
+
+// IsOk returns true if the Result contains a value and false otherwise.
+func (r Result[A]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result contains an error and false otherwise.
+func (r Result[A]) IsErr() bool {
+	return !r.IsOk()
+}
+
+// MapErr applies the given Function to the error in the Result if it exists. If the Function
+// returns a nil error then the error is considered handled and a Result containing the zero value
+// of A is returned.
+func (r Result[A]) MapErr(f fn.Function[error, error]) Result[A] {
+	if r.IsOk() {
+		return r
+	}
+
+	err := f(r.err)
+	if err == nil {
+		var zero A
+		return Ok(zero)
+	}
+
+	return Err[A](err)
+}
+
+// Or returns the value contained in the Result if it exists, otherwise it returns the
+// specified value.
+func (r Result[A]) Or(value A) A {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return value
+}
+
+// OrElse returns the value contained in the Result if it exists, otherwise it returns the
+// value returned by applying the specified Function to the error.
+func (r Result[A]) OrElse(f fn.Function[error, A]) A {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return f(r.err)
+}
+
+// ToMaybe converts the Result to a maybe.Maybe. The maybe.Maybe will contain the value if it
+// exists and will be empty if the Result contains an error.
+func (r Result[A]) ToMaybe() maybe.Maybe[A] {
+	if r.IsErr() {
+		return maybe.Nothing[A]()
+	}
+
+	return maybe.Just(r.value)
+}
+
+// Unwrap returns the value of type A contained in the Result. If this function is invoked on a
+// Result containing an error then it will cause a panic with an error wrapping that error.
+func (r Result[A]) Unwrap() A {
+	if r.IsErr() {
+		panic(fmt.Errorf("Unwrap() called on an Err Result: %w", r.err))
+	}
+
+	return r.value
+}
+
+// String returns a string representation of the Result.
+func (r Result[A]) String() string {
+	if r.IsOk() {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	} else {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+}
+
+// jsonResult is the JSON representation of a Result.
+type jsonResult struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err *string         `json:"err,omitempty"`
+}
+
+// MarshalJSON converts the Result to it's JSON representation. A Result containing a value is
+// represented as an object with the value under the "ok" key and a Result containing an error is
+// represented as an object with the error message under the "err" key. Unlike maybe.Maybe, whose
+// JSON representation is the bare value or null, the object is required because the error message
+// has to be kept and a bare value could not be told apart from it, e.g. for a Result[string].
+func (r Result[_]) MarshalJSON() ([]byte, error) {
+	if r.IsErr() {
+		msg := r.err.Error()
+		return json.Marshal(jsonResult{Err: &msg})
+	}
+
+	value, err := json.Marshal(r.value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Result value to JSON: %w", err)
+	}
+
+	return json.Marshal(jsonResult{Ok: value})
+}
+
+// UnmarshalJSON converts the JSON bytes to the value or error contained in the Result.
+func (r *Result[A]) UnmarshalJSON(data []byte) error {
+	var jr jsonResult
+
+	err := json.Unmarshal(data, &jr)
+	if err != nil {
+		return fmt.Errorf("unmarshal Result from JSON: %w", err)
+	}
+
+	if jr.Err != nil {
+		*r = Err[A](errors.New(*jr.Err))
+		return nil
+	}
+
+	var value A
+
+	if jr.Ok != nil {
+		err = json.Unmarshal(jr.Ok, &value)
+		if err != nil {
+			return fmt.Errorf("unmarshal Result value from JSON: %w", err)
+		}
+	}
+
+	*r = Ok(value)
+
+	return nil
+}
+
+// FlatMap applies the given Function to the value in the Result if it exists.
+func FlatMap[A, B any](r Result[A], f fn.Function[A, Result[B]]) Result[B] {
+	if r.IsErr() {
+		return Err[B](r.err)
+	}
+
+	return f(r.value)
+}
+
+// Map applies the given Function to the value in the Result if it exists.
+func Map[A, B any](r Result[A], f fn.Function[A, B]) Result[B] {
+	if r.IsErr() {
+		return Err[B](r.err)
+	}
+
+	return Ok(f(r.value))
+}
@@ -0,0 +1,288 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/fn"
+	"github.com/dustin10/itrz/maybe"
+	"github.com/dustin10/itrz/result"
+)
+
+var errTest = errors.New("test error")
+
+func Test_CreateAndPresence(t *testing.T) {
+	ok := result.Ok("value")
+
+	assert.True(t, ok.IsOk(), "expected value present for Ok")
+	assert.False(t, ok.IsErr(), "expected value present for Ok")
+	assert.Nil(t, ok.Err(), "unexpected error for Ok")
+	assert.Equal(t, "value", ok.Unwrap(), "unexpected value for Ok")
+
+	e := result.Err[string](errTest)
+
+	assert.True(t, e.IsErr(), "expected error present for Err")
+	assert.False(t, e.IsOk(), "expected error present for Err")
+	assert.Equal(t, errTest, e.Err(), "unexpected error for Err")
+
+	assert.Panics(t, func() { result.Err[string](nil) }, "expected Err() to panic for nil error")
+}
+
+func Test_From(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		expect bool
+	}{
+		"valid":   {value: "1", expect: true},
+		"invalid": {value: "x", expect: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := result.From(strconv.Atoi(test.value))
+
+			assert.Equal(t, test.expect, r.IsOk())
+		})
+	}
+}
+
+func Test_FromMaybe(t *testing.T) {
+	tests := map[string]struct {
+		value  maybe.Maybe[int]
+		expect bool
+	}{
+		"Just":    {value: maybe.Just(1), expect: true},
+		"Nothing": {value: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := result.FromMaybe(test.value, errTest)
+
+			assert.Equal(t, test.expect, r.IsOk())
+
+			if !test.expect {
+				assert.ErrorIs(t, r.Err(), errTest)
+			}
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+	value, err := result.Ok(1).Get()
+
+	assert.Equal(t, 1, value)
+	assert.Nil(t, err)
+
+	_, err = result.Err[int](errTest).Get()
+
+	assert.ErrorIs(t, err, errTest)
+}
+
+func Test_MapErr(t *testing.T) {
+	wrap := func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	}
+
+	tests := map[string]struct {
+		value  result.Result[int]
+		expect string
+	}{
+		"Ok is unchanged": {value: result.Ok(1)},
+		"Err is wrapped":  {value: result.Err[int](errTest), expect: "wrapped: test error"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := test.value.MapErr(wrap)
+
+			if test.expect == "" {
+				assert.True(t, r.IsOk())
+			} else {
+				assert.EqualError(t, r.Err(), test.expect)
+				assert.ErrorIs(t, r.Err(), errTest)
+			}
+		})
+	}
+}
+
+func Test_MapErr_Nil(t *testing.T) {
+	r := result.Err[int](errTest).MapErr(func(error) error { return nil })
+
+	assert.True(t, r.IsOk())
+	assert.Nil(t, r.Err())
+	assert.Equal(t, 0, r.Unwrap())
+}
+
+func Test_Or(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[string]
+		expect string
+	}{
+		"Ok":  {value: result.Ok("value"), expect: "value"},
+		"Err": {value: result.Err[string](errTest), expect: "default"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.value.Or("default"))
+		})
+	}
+}
+
+func Test_OrElse(t *testing.T) {
+	f := func(err error) string {
+		return err.Error()
+	}
+
+	tests := map[string]struct {
+		value  result.Result[string]
+		f      fn.Function[error, string]
+		expect string
+	}{
+		"Ok":  {value: result.Ok("value"), f: f, expect: "value"},
+		"Err": {value: result.Err[string](errTest), f: f, expect: "test error"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.value.OrElse(test.f))
+		})
+	}
+}
+
+func Test_ToMaybe(t *testing.T) {
+	assert.Equal(t, maybe.Just(1), result.Ok(1).ToMaybe())
+	assert.Equal(t, maybe.Nothing[int](), result.Err[int](errTest).ToMaybe())
+}
+
+func Test_Unwrap(t *testing.T) {
+	assert.Equal(t, 1, result.Ok(1).Unwrap())
+
+	defer func() {
+		r := recover()
+
+		err, ok := r.(error)
+
+		assert.True(t, ok, "expected Unwrap() to panic with an error")
+		assert.ErrorIs(t, err, errTest)
+	}()
+
+	result.Err[int](errTest).Unwrap()
+}
+
+func Test_String(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[string]
+		expect string
+	}{
+		"Ok":  {value: result.Ok("value"), expect: "Ok(value)"},
+		"Err": {value: result.Err[string](errTest), expect: "Err(test error)"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, test.value.String())
+		})
+	}
+}
+
+func Test_MarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[string]
+		expect []byte
+	}{
+		"Ok":  {value: result.Ok("value"), expect: []byte(`{"ok":"value"}`)},
+		"Err": {value: result.Err[string](errTest), expect: []byte(`{"err":"test error"}`)},
+
+		// unlike maybe.Maybe the value is wrapped so that it can be told apart from an error message
+		"Ok containing message": {value: result.Ok("test error"), expect: []byte(`{"ok":"test error"}`)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := test.value.MarshalJSON()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, res)
+		})
+	}
+}
+
+func Test_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		value     []byte
+		expect    string
+		expectErr string
+	}{
+		"Ok":  {value: []byte(`{"ok":"value"}`), expect: "value"},
+		"Err": {value: []byte(`{"err":"test error"}`), expect: "default", expectErr: "test error"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var r result.Result[string]
+
+			err := r.UnmarshalJSON(test.value)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expect, r.Or("default"))
+
+			if test.expectErr != "" {
+				assert.EqualError(t, r.Err(), test.expectErr)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalJSON_Invalid(t *testing.T) {
+	var r result.Result[int]
+
+	assert.Error(t, r.UnmarshalJSON([]byte(`"value"`)))
+	assert.Error(t, r.UnmarshalJSON([]byte(`{"ok":"value"}`)))
+}
+
+func Test_FlatMap(t *testing.T) {
+	parse := func(s string) result.Result[int] {
+		return result.From(strconv.Atoi(s))
+	}
+
+	tests := map[string]struct {
+		value  result.Result[string]
+		expect int
+	}{
+		"Ok when Function maps to Ok":  {value: result.Ok("1"), expect: 1},
+		"Ok when Function maps to Err": {value: result.Ok("x"), expect: -1},
+		"Err always maps to Err":       {value: result.Err[string](errTest), expect: -1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, result.FlatMap(test.value, parse).Or(-1))
+		})
+	}
+}
+
+func Test_Map(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[string]
+		expect int
+	}{
+		"Ok should map to Ok":   {value: result.Ok("value"), expect: 5},
+		"Err should map to Err": {value: result.Err[string](errTest), expect: -1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expect, result.Map(test.value, strlen).Or(-1))
+		})
+	}
+}
+
+func strlen(s string) int {
+	return len(s)
+}
@@ -22,6 +22,51 @@ func All2[A comparable, B any](m map[A]B) Seq2[A, B] {
 	}
 }
 
+// AllMatch returns true if all tuple elements in the Seq2 match the specified fn.Predicate2.
+func (s Seq2[A, B]) AllMatch(p fn.Predicate2[A, B]) bool {
+	for a, b := range s {
+		if !p(a, b) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AnyMatch returns true if any of the tuple elements in the Seq2 match the specified
+// fn.Predicate2.
+func (s Seq2[A, B]) AnyMatch(p fn.Predicate2[A, B]) bool {
+	for a, b := range s {
+		if p(a, b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of tuple elements yielded by the Seq2.
+func (s Seq2[A, B]) Count() int {
+	count := 0
+	for range s {
+		count = count + 1
+	}
+
+	return count
+}
+
+// Filter returns a Seq2 that only yields tuple elements from the original Seq2 that match
+// the specified fn.Predicate2.
+func (s Seq2[A, B]) Filter(p fn.Predicate2[A, B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for a, b := range s {
+			if p(a, b) && !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
 // FlatMap2 applies the fn.Function2, that itself returns a Seq, to each tuple element
 // yielded by the Seq2 and flattens them out into one Seq.
 func FlatMap2[A, B, C any](seq Seq2[A, B], f fn.Function2[A, B, Seq[C]]) Seq[C] {
@@ -37,6 +82,47 @@ func FlatMap2[A, B, C any](seq Seq2[A, B], f fn.Function2[A, B, Seq[C]]) Seq[C]
 	}
 }
 
+// ForEach applies the given fn.Consumer2 to each tuple element yielded by the Seq2.
+func (s Seq2[A, B]) ForEach(c fn.Consumer2[A, B]) {
+	for a, b := range s {
+		c(a, b)
+	}
+}
+
+// Keys returns a Seq that yields the first value of each tuple element of the Seq2.
+func (s Seq2[A, B]) Keys() Seq[A] {
+	return func(yield func(A) bool) {
+		for a := range s {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Limit returns a new Seq2 that will only yield limit number of tuple elements. The existing
+// Seq2 is not advanced any further once the limit has been reached. A limit that is zero or
+// negative yields no elements.
+func (s Seq2[A, B]) Limit(limit int) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		if limit <= 0 {
+			return
+		}
+
+		count := 0
+		for a, b := range s {
+			if !yield(a, b) {
+				return
+			}
+
+			count = count + 1
+			if count == limit {
+				return
+			}
+		}
+	}
+}
+
 // Map2 returns a new Seq consisting of the results of applying the given fn.Function2 to
 // the elements of the existing Seq2.
 func Map2[A, B, C any](seq Seq2[A, B], f fn.Function2[A, B, C]) Seq[C] {
@@ -49,9 +135,98 @@ func Map2[A, B, C any](seq Seq2[A, B], f fn.Function2[A, B, C]) Seq[C] {
 	}
 }
 
+// NoneMatch returns true if no tuple element yielded by the Seq2 matches the fn.Predicate2.
+func (s Seq2[A, B]) NoneMatch(p fn.Predicate2[A, B]) bool {
+	return !s.AnyMatch(p)
+}
+
 // Pull2 is a convenience function for procuring a pull-style iterator for a Seq2. Refer
 // to the iter.Pull2 documentation for more details on pull-style iterators and how to
 // work with them correctly.
 func Pull2[A, B, C any](seq Seq2[A, B]) (func() (A, B, bool), func()) {
 	return iter.Pull2(iter.Seq2[A, B](seq))
 }
+
+// Reduce2 performs a reduction on the tuple elements of the Seq2, using the provided identity
+// value and an associative accumulation function, and returns the reduced value.
+func Reduce2[A, B, C any](seq Seq2[A, B], identity C, f fn.Function3[A, B, C, C]) C {
+	result := identity
+
+	for a, b := range seq {
+		result = f(a, b, result)
+	}
+
+	return result
+}
+
+// Skip returns a Seq2 consisting of the remaining tuple elements of the existing Seq2 after
+// discarding the first n tuple elements.
+func (s Seq2[A, B]) Skip(n int) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		skipped := 0
+		for a, b := range s {
+			if skipped < n {
+				skipped = skipped + 1
+				continue
+			}
+
+			if !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// Swap returns a Seq2 that yields the tuple elements of the Seq2 with the order of the values
+// in each tuple reversed.
+func (s Seq2[A, B]) Swap() Seq2[B, A] {
+	return func(yield func(B, A) bool) {
+		for a, b := range s {
+			if !yield(b, a) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns a Seq2 that produces tuple elements as long as the fn.Predicate2 returns
+// true. Tuple elements will stop being produced when the first one fails.
+func (s Seq2[A, B]) TakeWhile(p fn.Predicate2[A, B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for a, b := range s {
+			if !p(a, b) || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap returns a map containing the tuple elements of the Seq2 with the first value of each
+// tuple as the key and the second as the value. If a key is yielded more than once then the
+// value of the last tuple element is retained.
+func ToMap[A comparable, B any](seq Seq2[A, B]) map[A]B {
+	m := make(map[A]B)
+	for a, b := range seq {
+		m[a] = b
+	}
+
+	return m
+}
+
+// Unzip splits the Seq2 into two Seq values, the first yielding the first value of each tuple
+// element and the second yielding the second value. Each of the returned Seq values iterates
+// the Seq2 independently.
+func (s Seq2[A, B]) Unzip() (Seq[A], Seq[B]) {
+	return s.Keys(), s.Values()
+}
+
+// Values returns a Seq that yields the second value of each tuple element of the Seq2.
+func (s Seq2[A, B]) Values() Seq[B] {
+	return func(yield func(B) bool) {
+		for _, b := range s {
+			if !yield(b) {
+				return
+			}
+		}
+	}
+}
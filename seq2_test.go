@@ -104,3 +104,196 @@ func Test_Map2(t *testing.T) {
 	}
 
 }
+
+func Test_Seq2_AllMatch(t *testing.T) {
+	tests := map[string]struct {
+		as       []int
+		bs       []int
+		expected bool
+	}{
+		"empty":        {as: []int{}, bs: []int{}, expected: true},
+		"all matching": {as: []int{1, 2}, bs: []int{1, 2}, expected: true},
+		"non-matching": {as: []int{1, 2}, bs: []int{1, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.ZipToShortest(itrz.All(test.as), itrz.All(test.bs))
+
+			assert.Equal(t, test.expected, s.AllMatch(equal))
+		})
+	}
+}
+
+func Test_Seq2_AnyMatch(t *testing.T) {
+	tests := map[string]struct {
+		as       []int
+		bs       []int
+		expected bool
+	}{
+		"empty":         {as: []int{}, bs: []int{}},
+		"one matching":  {as: []int{1, 2}, bs: []int{3, 2}, expected: true},
+		"none matching": {as: []int{1, 2}, bs: []int{2, 1}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.ZipToShortest(itrz.All(test.as), itrz.All(test.bs))
+
+			assert.Equal(t, test.expected, s.AnyMatch(equal))
+			assert.Equal(t, !test.expected, s.NoneMatch(equal))
+		})
+	}
+}
+
+func Test_Seq2_Count(t *testing.T) {
+	assert.Equal(t, 0, itrz.All2(map[string]int{}).Count())
+	assert.Equal(t, 2, itrz.All2(map[string]int{"a": 1, "b": 2}).Count())
+}
+
+func Test_Seq2_Filter(t *testing.T) {
+	s := itrz.Zip(itrz.Of(1, 2, 3), itrz.Of(1, 5, 3)).Filter(equal)
+
+	assert.Equal(t, []int{1, 3}, s.Keys().ToSlice())
+}
+
+func Test_Seq2_ForEach(t *testing.T) {
+	res := make([]string, 0)
+
+	itrz.Zip(itrz.Of(1, 2), itrz.Of("a", "b")).ForEach(func(n int, s string) {
+		res = append(res, fmt.Sprintf("%d%s", n, s))
+	})
+
+	assert.Equal(t, []string{"1a", "2b"}, res)
+}
+
+func Test_Seq2_KeysValues(t *testing.T) {
+	s := itrz.Zip(itrz.Of(1, 2, 3), itrz.Of("a", "b", "c"))
+
+	assert.Equal(t, []int{1, 2, 3}, s.Keys().ToSlice())
+	assert.Equal(t, []string{"a", "b", "c"}, s.Values().ToSlice())
+	assert.Equal(t, []int{1}, s.Keys().Limit(1).ToSlice())
+}
+
+func Test_Seq2_Limit(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		limit    int
+		expected []int
+	}{
+		"empty":       {values: []int{}, limit: 2, expected: []int{}},
+		"zero":        {values: []int{1, 2}, expected: []int{}},
+		"negative":    {values: []int{1, 2}, limit: -1, expected: []int{}},
+		"non-empty":   {values: []int{1, 2, 3, 4}, limit: 2, expected: []int{1, 2}},
+		"above count": {values: []int{1, 2}, limit: 5, expected: []int{1, 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.Zip(itrz.All(test.values), itrz.All(test.values)).Limit(test.limit)
+
+			assert.Equal(t, test.expected, s.Keys().ToSlice())
+		})
+	}
+}
+
+func Test_Seq2_Limit_NoOverPull(t *testing.T) {
+	tests := map[string]struct {
+		limit  int
+		pulled int
+	}{
+		"zero":     {limit: 0, pulled: 0},
+		"negative": {limit: -1, pulled: 0},
+		"one":      {limit: 1, pulled: 1},
+		"three":    {limit: 3, pulled: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pulled := 0
+
+			counted := func(int, int) bool {
+				pulled++
+				return true
+			}
+
+			s := itrz.Zip(itrz.Of(1, 2, 3, 4, 5), itrz.Of(1, 2, 3, 4, 5)).Filter(counted).Limit(test.limit)
+
+			s.ForEach(func(int, int) {})
+
+			assert.Equal(t, test.pulled, pulled)
+		})
+	}
+}
+
+func Test_Reduce2(t *testing.T) {
+	s := itrz.Zip(itrz.Of(1, 2, 3), itrz.Of(10, 20, 30))
+
+	res := itrz.Reduce2(s, 0, func(a, b, acc int) int { return acc + a*b })
+
+	assert.Equal(t, 140, res)
+	assert.Equal(t, 7, itrz.Reduce2(itrz.All2(map[int]int{}), 7, func(a, b, acc int) int { return 0 }))
+}
+
+func Test_Seq2_Skip(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		n        int
+		expected []int
+	}{
+		"empty":     {values: []int{}, n: 2, expected: []int{}},
+		"skip 0":    {values: []int{1, 2}, expected: []int{1, 2}},
+		"non-empty": {values: []int{1, 2, 3, 4}, n: 2, expected: []int{3, 4}},
+		"skip all":  {values: []int{1, 2}, n: 5, expected: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.Zip(itrz.All(test.values), itrz.All(test.values)).Skip(test.n)
+
+			assert.Equal(t, test.expected, s.Keys().ToSlice())
+			assert.Equal(t, test.expected, s.Values().ToSlice())
+		})
+	}
+}
+
+func Test_Seq2_Swap(t *testing.T) {
+	s := itrz.Zip(itrz.Of(1, 2), itrz.Of("a", "b")).Swap()
+
+	assert.Equal(t, []string{"a", "b"}, s.Keys().ToSlice())
+	assert.Equal(t, []int{1, 2}, s.Values().ToSlice())
+}
+
+func Test_Seq2_TakeWhile(t *testing.T) {
+	s := itrz.Zip(itrz.Of(1, 2, 3, 4), itrz.Of(1, 2, 0, 4)).TakeWhile(equal)
+
+	assert.Equal(t, []int{1, 2}, s.Keys().ToSlice())
+}
+
+func Test_ToMap(t *testing.T) {
+	tests := map[string]struct {
+		seq      itrz.Seq2[string, int]
+		expected map[string]int
+	}{
+		"empty":      {seq: itrz.All2(map[string]int{}), expected: map[string]int{}},
+		"map":        {seq: itrz.All2(map[string]int{"a": 1, "b": 2}), expected: map[string]int{"a": 1, "b": 2}},
+		"duplicates": {seq: itrz.Zip(itrz.Of("a", "b", "a"), itrz.Of(1, 2, 3)), expected: map[string]int{"a": 3, "b": 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.ToMap(test.seq))
+		})
+	}
+}
+
+func Test_Seq2_Unzip(t *testing.T) {
+	as, bs := itrz.Zip(itrz.Of(1, 2, 3), itrz.Of("a", "b", "c")).Unzip()
+
+	assert.Equal(t, []int{1, 2, 3}, as.ToSlice())
+	assert.Equal(t, []string{"a", "b", "c"}, bs.ToSlice())
+}
+
+func equal(a, b int) bool {
+	return a == b
+}
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"golang.org/x/exp/constraints"
 
 	"github.com/dustin10/itrz"
 	"github.com/dustin10/itrz/fn"
@@ -297,19 +296,47 @@ func Test_GenerateWithLast(t *testing.T) {
 
 func Test_Seq_Limit(t *testing.T) {
 	tests := map[string]struct {
-		values []int
-		limit  int
+		values   []int
+		limit    int
+		expected []int
 	}{
-		"empty":     {values: []int{}},
-		"nil":       {values: nil},
-		"non-empty": {values: []int{1, 2, 3, 4}, limit: 2},
+		"empty":       {values: []int{}, limit: 2, expected: []int{}},
+		"nil":         {values: nil, limit: 2, expected: []int{}},
+		"zero":        {values: []int{1, 2}, limit: 0, expected: []int{}},
+		"negative":    {values: []int{1, 2}, limit: -1, expected: []int{}},
+		"non-empty":   {values: []int{1, 2, 3, 4}, limit: 2, expected: []int{1, 2}},
+		"above count": {values: []int{1, 2}, limit: 5, expected: []int{1, 2}},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			s := itrz.All(test.values).Limit(test.limit)
 
-			assert.Equal(t, test.limit, countElems(s))
+			assert.Equal(t, test.expected, consumeSeq(s))
+		})
+	}
+}
+
+func Test_Seq_Limit_NoOverPull(t *testing.T) {
+	tests := map[string]struct {
+		limit  int
+		pulled int
+	}{
+		"zero":     {limit: 0, pulled: 0},
+		"negative": {limit: -1, pulled: 0},
+		"one":      {limit: 1, pulled: 1},
+		"three":    {limit: 3, pulled: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pulled := 0
+
+			s := itrz.Of(1, 2, 3, 4, 5).Peek(func(int) { pulled++ }).Limit(test.limit)
+
+			s.ForEach(func(int) {})
+
+			assert.Equal(t, test.pulled, pulled)
 		})
 	}
 }
@@ -633,11 +660,7 @@ func Test_ZipStrict(t *testing.T) {
 	}
 }
 
-type Number interface {
-	constraints.Integer | constraints.Float
-}
-
-func sum[A Number](a A, b A) A {
+func sum[A itrz.Number](a A, b A) A {
 	return a + b
 }
 
@@ -0,0 +1,174 @@
+package itrz
+
+import (
+	"iter"
+
+	"github.com/dustin10/itrz/fn"
+)
+
+// SeqE is a derived type of iter.Seq2 that yields elements of type A alongside an error. A
+// non-nil error indicates that the sequence failed, in which case the accompanying element
+// is the zero value of A and no further elements are yielded.
+type SeqE[A any] iter.Seq2[A, error]
+
+// Fail returns a SeqE which yields the specified error and then stops.
+func Fail[A any](err error) SeqE[A] {
+	return func(yield func(A, error) bool) {
+		yieldErr(yield, err)
+	}
+}
+
+// Infallible returns a SeqE that yields the elements of the specified Seq with a nil error.
+// It can be used to feed an infallible stage of a pipeline into a fallible one.
+func Infallible[A any](seq Seq[A]) SeqE[A] {
+	return func(yield func(A, error) bool) {
+		for a := range seq {
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a Seq that yields the elements of the SeqE until the first error is
+// encountered. The error, if any, is stored in the location pointed to by err once the
+// returned Seq stops. It can be used to feed a fallible stage of a pipeline into an
+// infallible one.
+func (s SeqE[A]) Values(err *error) Seq[A] {
+	return func(yield func(A) bool) {
+		*err = nil
+
+		for a, e := range s {
+			if e != nil {
+				*err = e
+				return
+			}
+
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// FilterE returns a SeqE that only yields elements from the original SeqE that match the
+// specified fn.PredicateE. Iteration stops at the first error returned by either the SeqE
+// or the fn.PredicateE and the error is yielded.
+func (s SeqE[A]) FilterE(p fn.PredicateE[A]) SeqE[A] {
+	return func(yield func(A, error) bool) {
+		for a, err := range s {
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			matches, err := p(a)
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			if matches && !yield(a, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ToSliceE returns a slice containing the elements of the SeqE. If an error is encountered
+// then the elements collected so far are returned along with the error.
+func (s SeqE[A]) ToSliceE() ([]A, error) {
+	as := make([]A, 0)
+	for a, err := range s {
+		if err != nil {
+			return as, err
+		}
+
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+// FlatMapE applies the fn.FunctionE, that itself returns a SeqE, to each element yielded by
+// the SeqE and flattens them out into one SeqE. Iteration stops at the first error returned by
+// the source SeqE, the fn.FunctionE or any of the mapped SeqE values and the error is yielded.
+func FlatMapE[A, B any](seq SeqE[A], f fn.FunctionE[A, SeqE[B]]) SeqE[B] {
+	return func(yield func(B, error) bool) {
+		for a, err := range seq {
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			mapped, err := f(a)
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			for b, err := range mapped {
+				if err != nil {
+					yieldErr(yield, err)
+					return
+				}
+
+				if !yield(b, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// MapE returns a new SeqE consisting of the results of applying the given fn.FunctionE to
+// the elements of the existing SeqE. Iteration stops at the first error returned by either
+// the SeqE or the fn.FunctionE and the error is yielded.
+func MapE[A, B any](seq SeqE[A], f fn.FunctionE[A, B]) SeqE[B] {
+	return func(yield func(B, error) bool) {
+		for a, err := range seq {
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			b, err := f(a)
+			if err != nil {
+				yieldErr(yield, err)
+				return
+			}
+
+			if !yield(b, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceE performs a reduction on the elements of the SeqE, using the provided identity value
+// and an associative accumulation function, and returns the reduced value. If an error is
+// encountered then the value reduced so far is returned along with the error.
+func ReduceE[A, B any](seq SeqE[A], identity B, f fn.Function2E[A, B, B]) (B, error) {
+	result := identity
+
+	for a, err := range seq {
+		if err != nil {
+			return result, err
+		}
+
+		next, err := f(a, result)
+		if err != nil {
+			return result, err
+		}
+
+		result = next
+	}
+
+	return result, nil
+}
+
+// yieldErr yields the zero value of A alongside the specified error.
+func yieldErr[A any](yield func(A, error) bool, err error) {
+	var zero A
+	yield(zero, err)
+}
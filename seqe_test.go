@@ -0,0 +1,214 @@
+package itrz_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+var errTest = errors.New("test error")
+
+func Test_Fail(t *testing.T) {
+	res, err := itrz.Fail[int](errTest).ToSliceE()
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Empty(t, res)
+}
+
+func Test_Infallible(t *testing.T) {
+	res, err := itrz.Infallible(itrz.Of(1, 2, 3)).ToSliceE()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+}
+
+func Test_SeqE_Values(t *testing.T) {
+	tests := map[string]struct {
+		seq         itrz.SeqE[int]
+		expected    []int
+		expectedErr error
+	}{
+		"empty":      {seq: itrz.Infallible(itrz.Empty[int]()), expected: []int{}},
+		"no error":   {seq: itrz.Infallible(itrz.Of(1, 2, 3)), expected: []int{1, 2, 3}},
+		"error":      {seq: failAfter(2), expected: []int{0, 1}, expectedErr: errTest},
+		"error only": {seq: itrz.Fail[int](errTest), expected: []int{}, expectedErr: errTest},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var err error
+
+			res := test.seq.Values(&err).ToSlice()
+
+			assert.Equal(t, test.expected, res)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func Test_SeqE_FilterE(t *testing.T) {
+	isEven := func(n int) (bool, error) { return n%2 == 0, nil }
+	failOn3 := func(n int) (bool, error) {
+		if n == 3 {
+			return false, errTest
+		}
+
+		return true, nil
+	}
+
+	tests := map[string]struct {
+		seq         itrz.SeqE[int]
+		p           func(int) (bool, error)
+		expected    []int
+		expectedErr error
+	}{
+		"empty":           {seq: itrz.Infallible(itrz.Empty[int]()), p: isEven, expected: []int{}},
+		"no error":        {seq: itrz.Infallible(itrz.Of(1, 2, 3, 4)), p: isEven, expected: []int{2, 4}},
+		"source error":    {seq: failAfter(3), p: isEven, expected: []int{0, 2}, expectedErr: errTest},
+		"predicate error": {seq: itrz.Infallible(itrz.Of(1, 2, 3, 4)), p: failOn3, expected: []int{1, 2}, expectedErr: errTest},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := test.seq.FilterE(test.p).ToSliceE()
+
+			assert.Equal(t, test.expected, res)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func Test_FlatMapE(t *testing.T) {
+	repeat := func(n int) (itrz.SeqE[int], error) {
+		return itrz.Infallible(itrz.Of(n, n)), nil
+	}
+
+	tests := map[string]struct {
+		seq         itrz.SeqE[int]
+		f           func(int) (itrz.SeqE[int], error)
+		expected    []int
+		expectedErr error
+	}{
+		"empty":        {seq: itrz.Infallible(itrz.Empty[int]()), f: repeat, expected: []int{}},
+		"no error":     {seq: itrz.Infallible(itrz.Of(1, 2)), f: repeat, expected: []int{1, 1, 2, 2}},
+		"source error": {seq: failAfter(1), f: repeat, expected: []int{0, 0}, expectedErr: errTest},
+		"function error": {seq: itrz.Infallible(itrz.Of(1, 2)), f: func(n int) (itrz.SeqE[int], error) {
+			if n == 2 {
+				return nil, errTest
+			}
+
+			return repeat(n)
+		}, expected: []int{1, 1}, expectedErr: errTest},
+		"mapped error": {seq: itrz.Infallible(itrz.Of(1, 2)), f: func(int) (itrz.SeqE[int], error) {
+			return itrz.Fail[int](errTest), nil
+		}, expected: []int{}, expectedErr: errTest},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrz.FlatMapE(test.seq, test.f).ToSliceE()
+
+			assert.Equal(t, test.expected, res)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func Test_MapE(t *testing.T) {
+	tests := map[string]struct {
+		seq         itrz.SeqE[string]
+		expected    []int
+		expectedErr bool
+	}{
+		"empty":          {seq: itrz.Infallible(itrz.Empty[string]()), expected: []int{}},
+		"no error":       {seq: itrz.Infallible(itrz.Of("1", "2", "3")), expected: []int{1, 2, 3}},
+		"function error": {seq: itrz.Infallible(itrz.Of("1", "x", "3")), expected: []int{1}, expectedErr: true},
+		"source error":   {seq: itrz.Fail[string](errTest), expected: []int{}, expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrz.MapE(test.seq, strconv.Atoi).ToSliceE()
+
+			assert.Equal(t, test.expected, res)
+			assert.Equal(t, test.expectedErr, err != nil)
+		})
+	}
+}
+
+func Test_MapE_StopsAtFirstError(t *testing.T) {
+	calls := 0
+	f := func(n int) (int, error) {
+		calls = calls + 1
+		return 0, errTest
+	}
+
+	count := 0
+	for _, err := range itrz.MapE(itrz.Infallible(itrz.Of(1, 2, 3)), f) {
+		assert.ErrorIs(t, err, errTest)
+		count = count + 1
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_ReduceE(t *testing.T) {
+	add := func(a, b int) (int, error) { return a + b, nil }
+
+	tests := map[string]struct {
+		seq         itrz.SeqE[int]
+		f           func(int, int) (int, error)
+		expected    int
+		expectedErr error
+	}{
+		"empty":        {seq: itrz.Infallible(itrz.Empty[int]()), f: add},
+		"no error":     {seq: itrz.Infallible(itrz.Of(1, 2, 3, 4)), f: add, expected: 10},
+		"source error": {seq: failAfter(3), f: add, expected: 3, expectedErr: errTest},
+		"function error": {seq: itrz.Infallible(itrz.Of(1, 2, 3, 4)), f: func(a, b int) (int, error) {
+			if a == 3 {
+				return 0, errTest
+			}
+
+			return a + b, nil
+		}, expected: 3, expectedErr: errTest},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := itrz.ReduceE(test.seq, 0, test.f)
+
+			assert.Equal(t, test.expected, res)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func Test_SeqE_ToSliceE(t *testing.T) {
+	res, err := itrz.Infallible(itrz.Of(1, 2, 3)).ToSliceE()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	res, err = failAfter(2).ToSliceE()
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, []int{0, 1}, res)
+}
+
+// failAfter returns a SeqE that yields the integers from zero to n-1 followed by errTest.
+func failAfter(n int) itrz.SeqE[int] {
+	return func(yield func(int, error) bool) {
+		for i := range n {
+			if !yield(i, nil) {
+				return
+			}
+		}
+
+		yield(0, errTest)
+	}
+}
@@ -0,0 +1,134 @@
+package set
+
+import (
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/fn"
+)
+
+// IsSubsetOf returns true if every element of the Set is also contained in the other Set.
+func (s *Set[A]) IsSubsetOf(other Set[A]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	return s.All().AllMatch(other.Contains)
+}
+
+// IsSupersetOf returns true if the Set contains every element of the other Set.
+func (s *Set[A]) IsSupersetOf(other Set[A]) bool {
+	return other.IsSubsetOf(*s)
+}
+
+// IsDisjoint returns true if the Set has no elements in common with the other Set.
+func (s *Set[A]) IsDisjoint(other Set[A]) bool {
+	small, large := smallest(*s, other)
+
+	return small.All().NoneMatch(large.Contains)
+}
+
+// Equal returns true if the Set and the other Set contain exactly the same elements.
+func (s *Set[A]) Equal(other Set[A]) bool {
+	return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
+// Difference returns a new Set containing the elements of a that are not contained in b. Every
+// element of a has to be examined, so a is always iterated regardless of which Set is smaller.
+func Difference[A comparable](a, b Set[A]) Set[A] {
+	result := create[A](Config{
+		InitialCapacity: a.Len(),
+	})
+
+	DifferenceSeq(a, b).DrainTo(&result)
+
+	return result
+}
+
+// DifferenceSeq returns an itrz.Seq that lazily yields the elements of a that are not
+// contained in b.
+func DifferenceSeq[A comparable](a, b Set[A]) itrz.Seq[A] {
+	return a.All().Filter(not(b.Contains))
+}
+
+// Intersection returns a new Set containing the elements that are contained in both a and b.
+// The smaller of the two Sets is iterated.
+func Intersection[A comparable](a, b Set[A]) Set[A] {
+	small, _ := smallest(a, b)
+
+	result := create[A](Config{
+		InitialCapacity: small.Len(),
+	})
+
+	IntersectionSeq(a, b).DrainTo(&result)
+
+	return result
+}
+
+// IntersectionSeq returns an itrz.Seq that lazily yields the elements that are contained in
+// both a and b. The smaller of the two Sets is iterated.
+func IntersectionSeq[A comparable](a, b Set[A]) itrz.Seq[A] {
+	small, large := smallest(a, b)
+
+	return small.All().Filter(large.Contains)
+}
+
+// SymmetricDifference returns a new Set containing the elements that are contained in exactly
+// one of a or b.
+func SymmetricDifference[A comparable](a, b Set[A]) Set[A] {
+	result := create[A](Config{
+		InitialCapacity: a.Len() + b.Len(),
+	})
+
+	SymmetricDifferenceSeq(a, b).DrainTo(&result)
+
+	return result
+}
+
+// SymmetricDifferenceSeq returns an itrz.Seq that lazily yields the elements that are contained
+// in exactly one of a or b.
+func SymmetricDifferenceSeq[A comparable](a, b Set[A]) itrz.Seq[A] {
+	return itrz.Concat(DifferenceSeq(a, b), DifferenceSeq(b, a))
+}
+
+// Union returns a new Set containing the elements that are contained in either a or b. The
+// larger of the two Sets is copied and the elements of the smaller one are added to it.
+func Union[A comparable](a, b Set[A]) Set[A] {
+	small, large := smallest(a, b)
+
+	result := clone(large)
+	small.All().DrainTo(&result)
+
+	return result
+}
+
+// UnionSeq returns an itrz.Seq that lazily yields the elements that are contained in either
+// a or b. Each element is yielded exactly once.
+func UnionSeq[A comparable](a, b Set[A]) itrz.Seq[A] {
+	return itrz.Concat(a.All(), DifferenceSeq(b, a))
+}
+
+// clone returns a new Set containing the same elements as the given Set.
+func clone[A comparable](s Set[A]) Set[A] {
+	result := create[A](Config{
+		InitialCapacity: s.Len(),
+	})
+
+	s.All().DrainTo(&result)
+
+	return result
+}
+
+// smallest returns the two given Sets ordered by the number of elements they contain.
+func smallest[A comparable](a, b Set[A]) (Set[A], Set[A]) {
+	if b.Len() < a.Len() {
+		return b, a
+	}
+
+	return a, b
+}
+
+// not returns a fn.Predicate that negates the result of the given one.
+func not[A any](p fn.Predicate[A]) fn.Predicate[A] {
+	return func(a A) bool {
+		return !p(a)
+	}
+}
@@ -0,0 +1,167 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/set"
+)
+
+func Test_Set_IsSubsetOf(t *testing.T) {
+	tests := map[string]struct {
+		a, b   []int
+		expect bool
+	}{
+		"both empty":   {a: []int{}, b: []int{}, expect: true},
+		"empty":        {a: []int{}, b: []int{1}, expect: true},
+		"equal":        {a: []int{1, 2}, b: []int{1, 2}, expect: true},
+		"proper":       {a: []int{1}, b: []int{1, 2}, expect: true},
+		"superset":     {a: []int{1, 2}, b: []int{1}},
+		"overlapping":  {a: []int{1, 3}, b: []int{1, 2}},
+		"disjoint":     {a: []int{3}, b: []int{1, 2}},
+		"empty other":  {a: []int{1}, b: []int{}},
+		"larger other": {a: []int{1, 5}, b: []int{1, 2, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := set.FromSlice(test.a)
+
+			assert.Equal(t, test.expect, a.IsSubsetOf(set.FromSlice(test.b)))
+		})
+	}
+}
+
+func Test_Set_IsSupersetOf(t *testing.T) {
+	tests := map[string]struct {
+		a, b   []int
+		expect bool
+	}{
+		"both empty":  {a: []int{}, b: []int{}, expect: true},
+		"empty other": {a: []int{1}, b: []int{}, expect: true},
+		"equal":       {a: []int{1, 2}, b: []int{1, 2}, expect: true},
+		"proper":      {a: []int{1, 2}, b: []int{1}, expect: true},
+		"subset":      {a: []int{1}, b: []int{1, 2}},
+		"overlapping": {a: []int{1, 3}, b: []int{1, 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := set.FromSlice(test.a)
+
+			assert.Equal(t, test.expect, a.IsSupersetOf(set.FromSlice(test.b)))
+		})
+	}
+}
+
+func Test_Set_IsDisjoint(t *testing.T) {
+	tests := map[string]struct {
+		a, b   []int
+		expect bool
+	}{
+		"both empty":  {a: []int{}, b: []int{}, expect: true},
+		"one empty":   {a: []int{1}, b: []int{}, expect: true},
+		"disjoint":    {a: []int{1, 2}, b: []int{3, 4, 5}, expect: true},
+		"overlapping": {a: []int{1, 2}, b: []int{2, 3, 4}},
+		"equal":       {a: []int{1, 2}, b: []int{1, 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := set.FromSlice(test.a)
+
+			assert.Equal(t, test.expect, a.IsDisjoint(set.FromSlice(test.b)))
+		})
+	}
+}
+
+func Test_Set_Equal(t *testing.T) {
+	tests := map[string]struct {
+		a, b   []int
+		expect bool
+	}{
+		"both empty":     {a: []int{}, b: []int{}, expect: true},
+		"equal":          {a: []int{1, 2}, b: []int{2, 1}, expect: true},
+		"subset":         {a: []int{1}, b: []int{1, 2}},
+		"superset":       {a: []int{1, 2}, b: []int{1}},
+		"same len":       {a: []int{1, 2}, b: []int{1, 3}},
+		"one empty":      {a: []int{}, b: []int{1}},
+		"with duplicate": {a: []int{1, 1, 2}, b: []int{1, 2}, expect: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := set.FromSlice(test.a)
+
+			assert.Equal(t, test.expect, a.Equal(set.FromSlice(test.b)))
+		})
+	}
+}
+
+func Test_Algebra(t *testing.T) {
+	type operation struct {
+		eager func(a, b set.Set[int]) set.Set[int]
+		lazy  func(a, b set.Set[int]) itrz.Seq[int]
+	}
+
+	union := operation{eager: set.Union[int], lazy: set.UnionSeq[int]}
+	intersection := operation{eager: set.Intersection[int], lazy: set.IntersectionSeq[int]}
+	difference := operation{eager: set.Difference[int], lazy: set.DifferenceSeq[int]}
+	symmetric := operation{eager: set.SymmetricDifference[int], lazy: set.SymmetricDifferenceSeq[int]}
+
+	tests := map[string]struct {
+		op       operation
+		a, b     []int
+		expected []int
+	}{
+		"union empty":                {op: union, a: []int{}, b: []int{}, expected: []int{}},
+		"union one empty":            {op: union, a: []int{1, 2}, b: []int{}, expected: []int{1, 2}},
+		"union overlapping":          {op: union, a: []int{1, 2, 3}, b: []int{3, 4}, expected: []int{1, 2, 3, 4}},
+		"union smaller first":        {op: union, a: []int{3, 4}, b: []int{1, 2, 3}, expected: []int{1, 2, 3, 4}},
+		"union disjoint":             {op: union, a: []int{1}, b: []int{2}, expected: []int{1, 2}},
+		"union both contain all":     {op: union, a: []int{1, 2}, b: []int{2, 1}, expected: []int{1, 2}},
+		"intersection empty":         {op: intersection, a: []int{}, b: []int{1}, expected: []int{}},
+		"intersection disjoint":      {op: intersection, a: []int{1, 2}, b: []int{3}, expected: []int{}},
+		"intersection overlapping":   {op: intersection, a: []int{1, 2, 3}, b: []int{2, 3, 4, 5}, expected: []int{2, 3}},
+		"intersection larger first":  {op: intersection, a: []int{2, 3, 4, 5}, b: []int{1, 2, 3}, expected: []int{2, 3}},
+		"intersection equal":         {op: intersection, a: []int{1, 2}, b: []int{1, 2}, expected: []int{1, 2}},
+		"intersection one empty":     {op: intersection, a: []int{1, 2}, b: []int{}, expected: []int{}},
+		"intersection subset second": {op: intersection, a: []int{1, 2, 3}, b: []int{2}, expected: []int{2}},
+		"difference empty":           {op: difference, a: []int{}, b: []int{1}, expected: []int{}},
+		"difference empty other":     {op: difference, a: []int{1, 2}, b: []int{}, expected: []int{1, 2}},
+		"difference overlapping":     {op: difference, a: []int{1, 2, 3}, b: []int{2, 3, 4, 5}, expected: []int{1}},
+		"difference larger first":    {op: difference, a: []int{1, 2, 3, 4}, b: []int{2, 5}, expected: []int{1, 3, 4}},
+		"difference disjoint":        {op: difference, a: []int{1, 2}, b: []int{3, 4}, expected: []int{1, 2}},
+		"difference equal":           {op: difference, a: []int{1, 2}, b: []int{1, 2}, expected: []int{}},
+		"difference superset other":  {op: difference, a: []int{1}, b: []int{1, 2, 3}, expected: []int{}},
+		"symmetric empty":            {op: symmetric, a: []int{}, b: []int{}, expected: []int{}},
+		"symmetric disjoint":         {op: symmetric, a: []int{1}, b: []int{2}, expected: []int{1, 2}},
+		"symmetric overlapping":      {op: symmetric, a: []int{1, 2, 3}, b: []int{2, 3, 4}, expected: []int{1, 4}},
+		"symmetric equal":            {op: symmetric, a: []int{1, 2}, b: []int{1, 2}, expected: []int{}},
+		"symmetric larger first":     {op: symmetric, a: []int{1, 2, 3, 4}, b: []int{4, 5}, expected: []int{1, 2, 3, 5}},
+		"symmetric one empty":        {op: symmetric, a: []int{}, b: []int{1, 2}, expected: []int{1, 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := set.FromSlice(test.a)
+			b := set.FromSlice(test.b)
+
+			eager := test.op.eager(a, b)
+
+			assert.True(t, eager.Equal(set.FromSlice(test.expected)))
+
+			lazy := test.op.lazy(a, b).ToSlice()
+			slices.Sort(lazy)
+
+			assert.Equal(t, test.expected, lazy)
+
+			// operands must not be modified
+			assert.True(t, a.Equal(set.FromSlice(test.a)))
+			assert.True(t, b.Equal(set.FromSlice(test.b)))
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/fn"
+)
+
+// ConcurrentSet is a collection that contains no duplicate elements and is safe for concurrent
+// use by multiple goroutines. Access to the elements is guarded by a sync.RWMutex. A ConcurrentSet
+// must not be copied after first use.
+type ConcurrentSet[A comparable] struct {
+	mu    sync.RWMutex
+	elems Set[A]
+}
+
+// NewConcurrent creates a new ConcurrentSet applying any Options that are specified.
+func NewConcurrent[A comparable](opts ...Option) *ConcurrentSet[A] {
+	return &ConcurrentSet[A]{
+		elems: New[A](opts...),
+	}
+}
+
+// ConcurrentFromSlice creates a new ConcurrentSet using the given slice as the initial data and
+// applying any Options that are specified.
+func ConcurrentFromSlice[S ~[]A, A comparable](as S, opts ...Option) *ConcurrentSet[A] {
+	return &ConcurrentSet[A]{
+		elems: FromSlice(as, opts...),
+	}
+}
+
+// IsEmpty returns true if the ConcurrentSet as zero elements and false otherwise.
+func (s *ConcurrentSet[A]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns the number of elements in the ConcurrentSet.
+func (s *ConcurrentSet[A]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.elems.Len()
+}
+
+// Add adds an element to the ConcurrentSet.
+func (s *ConcurrentSet[A]) Add(a A) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.elems.Add(a)
+}
+
+// AddIfAbsent atomically adds the specified element to the ConcurrentSet if it is not already
+// contained in it. Returns true if the value was added to the ConcurrentSet.
+func (s *ConcurrentSet[A]) AddIfAbsent(a A) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.elems.Contains(a) {
+		return false
+	}
+
+	s.elems.Add(a)
+
+	return true
+}
+
+// Remove removes the specified element from the ConcurrentSet. Returns true if the value was
+// removed from the ConcurrentSet.
+func (s *ConcurrentSet[A]) Remove(a A) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.elems.Remove(a)
+}
+
+// RemoveIf atomically removes all elements from the ConcurrentSet that match the specified
+// fn.Predicate. Returns the number of elements that were removed. The fn.Predicate is invoked
+// while the lock is held, so it must not access the ConcurrentSet.
+func (s *ConcurrentSet[A]) RemoveIf(p fn.Predicate[A]) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for a := range s.elems.elems {
+		if p(a) {
+			delete(s.elems.elems, a)
+			removed = removed + 1
+		}
+	}
+
+	return removed
+}
+
+// Contains returns true if the ConcurrentSet contains the specified element or false otherwise.
+func (s *ConcurrentSet[A]) Contains(a A) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.elems.Contains(a)
+}
+
+// Clear removes all values in the ConcurrentSet.
+func (s *ConcurrentSet[A]) Clear() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.elems.Clear()
+}
+
+// All returns an itrz.Seq that can be used to range over the ConcurrentSet. The elements are
+// copied into a snapshot when iteration starts, so the itrz.Seq yields exactly the elements
+// that were contained in the ConcurrentSet at that point in time and is unaffected by any
+// concurrent modifications. Ranging over the itrz.Seq again takes a new snapshot.
+func (s *ConcurrentSet[A]) All() itrz.Seq[A] {
+	return func(yield func(A) bool) {
+		s.mu.RLock()
+		as := s.elems.All().ToSlice()
+		s.mu.RUnlock()
+
+		for _, a := range as {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a new Set containing the elements of the ConcurrentSet at the time of the
+// call.
+func (s *ConcurrentSet[A]) Snapshot() Set[A] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return clone(s.elems)
+}
+
+// String returns a string representation of the ConcurrentSet.
+func (s *ConcurrentSet[A]) String() string {
+	f := func(a A) string {
+		return fmt.Sprintf("%v", a)
+	}
+
+	as := itrz.Map(s.All(), f).ToSlice()
+
+	return fmt.Sprintf("[%s]", strings.Join(as, ","))
+}
+
+// MarshalJSON converts the ConcurrentSet to it's JSON representation.
+func (s *ConcurrentSet[A]) MarshalJSON() ([]byte, error) {
+	as := s.All().ToSlice()
+
+	bytes, err := json.Marshal(&as)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ConcurrentSet to JSON: %w", err)
+	}
+
+	return bytes, nil
+}
+
+// UnmarshalJSON converts the JSON bytes to the values contained in the ConcurrentSet.
+func (s *ConcurrentSet[A]) UnmarshalJSON(data []byte) error {
+	as := make([]A, 0)
+
+	err := json.Unmarshal(data, &as)
+	if err != nil {
+		return fmt.Errorf("unmarshal JSON to ConcurrentSet: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.elems.elems == nil {
+		s.elems = New[A]()
+	}
+
+	for _, a := range as {
+		s.elems.Add(a)
+	}
+
+	return nil
+}
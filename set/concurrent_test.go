@@ -0,0 +1,199 @@
+package set_test
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/set"
+)
+
+func Test_NewConcurrent(t *testing.T) {
+	s := set.NewConcurrent[int]()
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Len())
+}
+
+func Test_ConcurrentFromSlice(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected []int
+	}{
+		"empty":      {values: []int{}, expected: []int{}},
+		"nil":        {values: nil, expected: []int{}},
+		"many":       {values: []int{1, 2, 3, 4}, expected: []int{1, 2, 3, 4}},
+		"duplicates": {values: []int{1, 2, 3, 1, 4, 3}, expected: []int{1, 2, 3, 4}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.ConcurrentFromSlice(test.values)
+
+			assert.Equal(t, len(test.expected), s.Len())
+
+			for _, e := range test.expected {
+				assert.True(t, s.Contains(e))
+			}
+		})
+	}
+}
+
+func Test_ConcurrentSet_AddRemove(t *testing.T) {
+	s := set.NewConcurrent[int]()
+
+	s.Add(1)
+	s.Add(1)
+
+	assert.Equal(t, 1, s.Len())
+	assert.True(t, s.Contains(1))
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+	assert.False(t, s.Contains(1))
+}
+
+func Test_ConcurrentSet_AddIfAbsent(t *testing.T) {
+	s := set.ConcurrentFromSlice([]int{1})
+
+	assert.False(t, s.AddIfAbsent(1))
+	assert.True(t, s.AddIfAbsent(2))
+	assert.Equal(t, 2, s.Len())
+}
+
+func Test_ConcurrentSet_RemoveIf(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		removed  int
+		expected []int
+	}{
+		"empty":      {values: []int{}, expected: []int{}},
+		"none match": {values: []int{1, 3}, expected: []int{1, 3}},
+		"some match": {values: []int{1, 2, 3, 4}, removed: 2, expected: []int{1, 3}},
+		"all match":  {values: []int{2, 4}, removed: 2, expected: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.ConcurrentFromSlice(test.values)
+
+			assert.Equal(t, test.removed, s.RemoveIf(func(n int) bool { return n%2 == 0 }))
+
+			res := s.All().ToSlice()
+			slices.Sort(res)
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func Test_ConcurrentSet_Clear(t *testing.T) {
+	s := set.ConcurrentFromSlice([]int{1, 2, 3})
+
+	assert.Equal(t, 3, s.Clear())
+	assert.Equal(t, 0, s.Len())
+}
+
+func Test_ConcurrentSet_Snapshot(t *testing.T) {
+	s := set.ConcurrentFromSlice([]int{1, 2, 3})
+
+	snapshot := s.Snapshot()
+	s.Add(4)
+
+	assert.Equal(t, 3, snapshot.Len())
+	assert.False(t, snapshot.Contains(4))
+}
+
+func Test_ConcurrentSet_All_IsSnapshot(t *testing.T) {
+	s := set.ConcurrentFromSlice([]int{1, 2, 3})
+
+	res := make([]int, 0)
+	for e := range s.All() {
+		// modifications during iteration must not be observed or deadlock
+		s.Add(e + 10)
+		s.Remove(e + 1)
+
+		res = append(res, e)
+	}
+
+	assert.Equal(t, 3, len(res))
+}
+
+func Test_ConcurrentSet_MarshalJSON(t *testing.T) {
+	s := set.ConcurrentFromSlice([]int{1})
+
+	res, err := json.Marshal(s)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("[1]"), res)
+	assert.Equal(t, "[1]", s.String())
+}
+
+func Test_ConcurrentSet_UnmarshalJSON(t *testing.T) {
+	var s set.ConcurrentSet[int]
+
+	err := json.Unmarshal([]byte("[1,2,2,3]"), &s)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, s.Len())
+
+	err = json.Unmarshal([]byte("{}"), &s)
+
+	assert.NotNil(t, err)
+}
+
+func Test_ConcurrentSet_Race(t *testing.T) {
+	s := set.NewConcurrent[int]()
+
+	var wg sync.WaitGroup
+	var added atomic.Int32
+
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				if s.AddIfAbsent(i) {
+					added.Add(1)
+				}
+
+				s.Contains(i)
+				s.Len()
+
+				if w%2 == 0 {
+					for range s.All() {
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(100), added.Load())
+	assert.Equal(t, 100, s.Len())
+
+	var removed atomic.Int32
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			removed.Add(int32(s.RemoveIf(func(n int) bool { return n%2 == 0 })))
+
+			for i := range 100 {
+				s.Remove(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(50), removed.Load())
+	assert.True(t, s.IsEmpty())
+}
@@ -0,0 +1,134 @@
+package set
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/gob"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dustin10/itrz/internal/text"
+)
+
+// MarshalText converts the Set to it's textual representation, which is a single CSV record
+// containing the textual representation of each element. An element that implements
+// encoding.TextMarshaler is converted using it, a string is converted as is and any other element
+// is converted to JSON.
+func (s Set[A]) MarshalText() ([]byte, error) {
+	fields := make([]string, 0, s.Len())
+
+	for a := range s.All() {
+		field, err := text.Marshal(a)
+		if err != nil {
+			return nil, fmt.Errorf("marshal Set to text: %w", err)
+		}
+
+		fields = append(fields, string(field))
+	}
+
+	// a lone empty field would otherwise be indistinguishable from an empty Set
+	if len(fields) == 1 && fields[0] == "" {
+		return []byte(`""`), nil
+	}
+
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(fields); err != nil {
+		return nil, fmt.Errorf("marshal Set to text: %w", err)
+	}
+
+	w.Flush()
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalText converts the text to the values contained in the Set.
+func (s *Set[A]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		s.addAll(nil)
+		return nil
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return fmt.Errorf("unmarshal text to Set: %w", err)
+	}
+
+	if len(records) != 1 {
+		return fmt.Errorf("unmarshal text to Set: expected a single record but found %d", len(records))
+	}
+
+	as := make([]A, len(records[0]))
+	for i, field := range records[0] {
+		if err := text.Unmarshal([]byte(field), &as[i]); err != nil {
+			return fmt.Errorf("unmarshal text to Set: %w", err)
+		}
+	}
+
+	s.addAll(as)
+
+	return nil
+}
+
+// MarshalYAML converts the Set to it's YAML representation, which is a sequence of the elements.
+func (s Set[A]) MarshalYAML() (any, error) {
+	return s.All().ToSlice(), nil
+}
+
+// UnmarshalYAML converts the YAML node to the values contained in the Set.
+func (s *Set[A]) UnmarshalYAML(node *yaml.Node) error {
+	as := make([]A, 0)
+
+	err := node.Decode(&as)
+	if err != nil {
+		return fmt.Errorf("unmarshal YAML to Set: %w", err)
+	}
+
+	s.addAll(as)
+
+	return nil
+}
+
+// GobEncode converts the Set to it's gob representation.
+func (s Set[A]) GobEncode() ([]byte, error) {
+	as := s.All().ToSlice()
+
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(&as)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Set to gob: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode converts the gob bytes to the values contained in the Set.
+func (s *Set[A]) GobDecode(data []byte) error {
+	as := make([]A, 0)
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&as)
+	if err != nil {
+		return fmt.Errorf("unmarshal gob to Set: %w", err)
+	}
+
+	s.addAll(as)
+
+	return nil
+}
+
+// addAll adds the elements to the Set, first initializing it if it is the zero value so that a
+// Set can be unmarshalled into without having been created with New.
+func (s *Set[A]) addAll(as []A) {
+	if s.elems == nil {
+		*s = New[A]()
+	}
+
+	for _, a := range as {
+		s.Add(a)
+	}
+}
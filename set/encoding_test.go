@@ -0,0 +1,163 @@
+package set_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dustin10/itrz/set"
+)
+
+type tagged struct {
+	Tags set.Set[string] `yaml:"tags"`
+}
+
+func Test_Set_Text(t *testing.T) {
+	tests := map[string]struct {
+		values []string
+	}{
+		"empty":            {values: []string{}},
+		"single":           {values: []string{"a"}},
+		"multiple":         {values: []string{"a", "b", "c"}},
+		"lone empty value": {values: []string{""}},
+		"empty values":     {values: []string{"", "a"}},
+		"quoted values":    {values: []string{"a,b", "c\"d", "e\nf"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.FromSlice(test.values)
+
+			data, err := s.MarshalText()
+
+			assert.Nil(t, err)
+
+			var res set.Set[string]
+
+			assert.Nil(t, res.UnmarshalText(data))
+			assert.True(t, s.Equal(res), "expected %v but got %v from %q", s, res, data)
+		})
+	}
+}
+
+func Test_Set_MarshalText(t *testing.T) {
+	res, err := set.FromSlice([]int{1}).MarshalText()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1", string(res))
+
+	res, err = set.FromSlice([]string{"a,b"}).MarshalText()
+
+	assert.Nil(t, err)
+	assert.Equal(t, `"a,b"`, string(res))
+}
+
+func Test_Set_UnmarshalText(t *testing.T) {
+	s := set.FromSlice([]int{1})
+
+	assert.Nil(t, s.UnmarshalText([]byte("2,3")))
+	assert.ElementsMatch(t, []int{1, 2, 3}, s.All().ToSlice())
+
+	tests := map[string]struct {
+		value string
+		err   error
+	}{
+		"invalid element":  {value: "1,x"},
+		"multiple records": {value: "1\n2"},
+		"invalid CSV":      {value: `1,"2`, err: csv.ErrQuote},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var s set.Set[int]
+
+			err := s.UnmarshalText([]byte(test.value))
+
+			assert.ErrorContains(t, err, "unmarshal text to Set:")
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
+
+func Test_Set_YAML(t *testing.T) {
+	res, err := yaml.Marshal(tagged{Tags: set.FromSlice([]string{"a"})})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "tags:\n    - a\n", string(res))
+
+	tests := map[string]struct {
+		yaml   string
+		expect []string
+	}{
+		"sequence":   {yaml: "tags: [a, b, a]\n", expect: []string{"a", "b"}},
+		"empty":      {yaml: "tags: []\n", expect: []string{}},
+		"null":       {yaml: "tags: ~\n", expect: []string{}},
+		"block list": {yaml: "tags:\n  - x\n  - y\n", expect: []string{"x", "y"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v tagged
+
+			assert.Nil(t, yaml.Unmarshal([]byte(test.yaml), &v))
+			assert.ElementsMatch(t, test.expect, v.Tags.All().ToSlice())
+		})
+	}
+
+	var v tagged
+
+	err = yaml.Unmarshal([]byte("tags: a\n"), &v)
+
+	assert.ErrorContains(t, err, "unmarshal YAML to Set:")
+}
+
+func Test_Set_Gob(t *testing.T) {
+	tests := map[string]struct {
+		values []string
+	}{
+		"empty":    {values: []string{}},
+		"multiple": {values: []string{"a", "b", "c"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			assert.Nil(t, gob.NewEncoder(&buf).Encode(tagged{Tags: set.FromSlice(test.values)}))
+
+			var v tagged
+
+			assert.Nil(t, gob.NewDecoder(&buf).Decode(&v))
+
+			res := v.Tags.All().ToSlice()
+			slices.Sort(res)
+
+			assert.Equal(t, test.values, res)
+		})
+	}
+
+	var s set.Set[int]
+
+	assert.ErrorContains(t, s.GobDecode([]byte("x")), "unmarshal gob to Set:")
+}
+
+func Test_Set_UnmarshalJSON_ZeroValue(t *testing.T) {
+	var v struct {
+		Tags set.Set[string] `json:"tags"`
+	}
+
+	err := json.NewDecoder(strings.NewReader(`{"tags":["a","b"]}`)).Decode(&v)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, v.Tags.Len())
+}
@@ -39,15 +39,7 @@ type Set[A comparable] struct {
 
 // New creates a new Set applying any Options that are specified.
 func New[A comparable](opts ...Option) Set[A] {
-	config := Config{
-		InitialCapacity: defaultInitialCapacity,
-	}
-
-	for _, opt := range opts {
-		opt(&config)
-	}
-
-	return create[A](config)
+	return create[A](newConfig(opts...))
 }
 
 // FromSlice creates a new Set using the given slice as the initial data and applying
@@ -62,6 +54,19 @@ func FromSlice[S ~[]A, A comparable](as S, opts ...Option) Set[A] {
 	return s
 }
 
+// newConfig returns the default Config with the specified Options applied.
+func newConfig(opts ...Option) Config {
+	config := Config{
+		InitialCapacity: defaultInitialCapacity,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
 func create[A comparable](config Config) Set[A] {
 	return Set[A]{
 		config: config,
@@ -148,9 +153,7 @@ func (s *Set[A]) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("unmarshal JSON to Set: %w", err)
 	}
 
-	for _, a := range as {
-		s.Add(a)
-	}
+	s.addAll(as)
 
 	return nil
 }
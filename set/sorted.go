@@ -0,0 +1,422 @@
+package set
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/maybe"
+)
+
+// SortedSet is a collection that contains no duplicate elements and keeps its elements ordered
+// by a comparator. It is backed by a self-balancing AVL tree, so adding, removing and looking up
+// an element are all O(log n) operations. Two elements are considered duplicates when the
+// comparator returns zero for them. A SortedSet must not be modified while it is being iterated.
+type SortedSet[A any] struct {
+	config  Config
+	compare func(a, b A) int
+	root    *node[A]
+	size    int
+}
+
+// NewSorted creates a new SortedSet whose elements are ordered by their natural ordering,
+// applying any Options that are specified.
+func NewSorted[A cmp.Ordered](opts ...Option) *SortedSet[A] {
+	return NewSortedFunc(cmp.Compare[A], opts...)
+}
+
+// NewSortedFunc creates a new SortedSet whose elements are ordered by the specified comparator,
+// applying any Options that are specified. The comparator must return a negative number when
+// a < b, a positive number when a > b and zero when a == b. The InitialCapacity of the Config has
+// no effect, as the nodes of the tree are allocated as elements are added. Panics if the
+// comparator is nil.
+func NewSortedFunc[A any](compare func(a, b A) int, opts ...Option) *SortedSet[A] {
+	if compare == nil {
+		panic("comparator must not be nil")
+	}
+
+	return &SortedSet[A]{
+		config:  newConfig(opts...),
+		compare: compare,
+	}
+}
+
+// SortedFromSlice creates a new SortedSet using the given slice as the initial data and applying
+// any Options that are specified.
+func SortedFromSlice[S ~[]A, A cmp.Ordered](as S, opts ...Option) *SortedSet[A] {
+	s := NewSorted[A](opts...)
+
+	for _, a := range as {
+		s.Add(a)
+	}
+
+	return s
+}
+
+// IsEmpty returns true if the SortedSet as zero elements and false otherwise.
+func (s *SortedSet[A]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns the number of elements in the SortedSet.
+func (s *SortedSet[A]) Len() int {
+	return s.size
+}
+
+// Add adds an element to the SortedSet.
+func (s *SortedSet[A]) Add(a A) {
+	var added bool
+
+	s.root, added = s.root.insert(a, s.compare)
+	if added {
+		s.size = s.size + 1
+	}
+}
+
+// Remove removes the specified element from the SortedSet. Returns true if the value was
+// removed from the SortedSet.
+func (s *SortedSet[A]) Remove(a A) bool {
+	var removed bool
+
+	s.root, removed = s.root.remove(a, s.compare)
+	if removed {
+		s.size = s.size - 1
+	}
+
+	return removed
+}
+
+// Contains returns true if the SortedSet contains the specified element or false otherwise.
+func (s *SortedSet[A]) Contains(a A) bool {
+	n := s.root
+	for n != nil {
+		c := s.compare(a, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear removes all values in the SortedSet.
+func (s *SortedSet[A]) Clear() int {
+	num := s.size
+
+	s.root = nil
+	s.size = 0
+
+	return num
+}
+
+// All returns an itrz.Seq that can be used to range over the SortedSet in ascending order.
+func (s *SortedSet[A]) All() itrz.Seq[A] {
+	return func(yield func(A) bool) {
+		s.root.ascend(yield)
+	}
+}
+
+// Backward returns an itrz.Seq that can be used to range over the SortedSet in descending order.
+func (s *SortedSet[A]) Backward() itrz.Seq[A] {
+	return func(yield func(A) bool) {
+		s.root.descend(yield)
+	}
+}
+
+// Range returns an itrz.Seq that yields, in ascending order, the elements of the SortedSet that
+// are greater than or equal to lo and strictly less than hi.
+func (s *SortedSet[A]) Range(lo, hi A) itrz.Seq[A] {
+	return func(yield func(A) bool) {
+		s.root.ascendRange(lo, hi, s.compare, yield)
+	}
+}
+
+// Min returns a maybe.Maybe containing the smallest element of the SortedSet, or an empty one
+// if the SortedSet has no elements.
+func (s *SortedSet[A]) Min() maybe.Maybe[A] {
+	if s.root == nil {
+		return maybe.Nothing[A]()
+	}
+
+	n := s.root
+	for n.left != nil {
+		n = n.left
+	}
+
+	return maybe.Just(n.value)
+}
+
+// Max returns a maybe.Maybe containing the largest element of the SortedSet, or an empty one
+// if the SortedSet has no elements.
+func (s *SortedSet[A]) Max() maybe.Maybe[A] {
+	if s.root == nil {
+		return maybe.Nothing[A]()
+	}
+
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+
+	return maybe.Just(n.value)
+}
+
+// Floor returns a maybe.Maybe containing the largest element of the SortedSet that is less than
+// or equal to the specified value, or an empty one if there is no such element.
+func (s *SortedSet[A]) Floor(a A) maybe.Maybe[A] {
+	result := maybe.Nothing[A]()
+
+	n := s.root
+	for n != nil {
+		c := s.compare(a, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			result = maybe.Just(n.value)
+			n = n.right
+		default:
+			return maybe.Just(n.value)
+		}
+	}
+
+	return result
+}
+
+// Ceiling returns a maybe.Maybe containing the smallest element of the SortedSet that is greater
+// than or equal to the specified value, or an empty one if there is no such element.
+func (s *SortedSet[A]) Ceiling(a A) maybe.Maybe[A] {
+	result := maybe.Nothing[A]()
+
+	n := s.root
+	for n != nil {
+		c := s.compare(a, n.value)
+		switch {
+		case c < 0:
+			result = maybe.Just(n.value)
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return maybe.Just(n.value)
+		}
+	}
+
+	return result
+}
+
+// String returns a string representation of the SortedSet with the elements in ascending order.
+func (s *SortedSet[A]) String() string {
+	f := func(a A) string {
+		return fmt.Sprintf("%v", a)
+	}
+
+	as := itrz.Map(s.All(), f).ToSlice()
+
+	return fmt.Sprintf("[%s]", strings.Join(as, ","))
+}
+
+// MarshalJSON converts the SortedSet to it's JSON representation with the elements in ascending
+// order.
+func (s *SortedSet[A]) MarshalJSON() ([]byte, error) {
+	as := s.All().ToSlice()
+
+	bytes, err := json.Marshal(&as)
+	if err != nil {
+		return nil, fmt.Errorf("marshal SortedSet to JSON: %w", err)
+	}
+
+	return bytes, nil
+}
+
+// UnmarshalJSON converts the JSON bytes to the values contained in the SortedSet. The SortedSet
+// must have been created with a comparator before it is unmarshalled into.
+func (s *SortedSet[A]) UnmarshalJSON(data []byte) error {
+	if s.compare == nil {
+		return errors.New("unmarshal JSON to SortedSet: no comparator configured")
+	}
+
+	as := make([]A, 0)
+
+	err := json.Unmarshal(data, &as)
+	if err != nil {
+		return fmt.Errorf("unmarshal JSON to SortedSet: %w", err)
+	}
+
+	for _, a := range as {
+		s.Add(a)
+	}
+
+	return nil
+}
+
+// node is a single node of the AVL tree backing a SortedSet.
+type node[A any] struct {
+	value  A
+	left   *node[A]
+	right  *node[A]
+	height int
+}
+
+func (n *node[A]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func (n *node[A]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+}
+
+func (n *node[A]) balanceFactor() int {
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+func (n *node[A]) rotateLeft() *node[A] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+
+	n.update()
+	r.update()
+
+	return r
+}
+
+func (n *node[A]) rotateRight() *node[A] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+
+	n.update()
+	l.update()
+
+	return l
+}
+
+// rebalance restores the AVL invariant for the subtree rooted at the node and returns the new
+// root of the subtree.
+func (n *node[A]) rebalance() *node[A] {
+	n.update()
+
+	switch bf := n.balanceFactor(); {
+	case bf > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	case bf < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+func (n *node[A]) insert(a A, compare func(a, b A) int) (*node[A], bool) {
+	if n == nil {
+		return &node[A]{value: a, height: 1}, true
+	}
+
+	var added bool
+
+	c := compare(a, n.value)
+	switch {
+	case c < 0:
+		n.left, added = n.left.insert(a, compare)
+	case c > 0:
+		n.right, added = n.right.insert(a, compare)
+	default:
+		return n, false
+	}
+
+	return n.rebalance(), added
+}
+
+func (n *node[A]) remove(a A, compare func(a, b A) int) (*node[A], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+
+	c := compare(a, n.value)
+	switch {
+	case c < 0:
+		n.left, removed = n.left.remove(a, compare)
+	case c > 0:
+		n.right, removed = n.right.remove(a, compare)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+
+		if n.right == nil {
+			return n.left, true
+		}
+
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		n.value = successor.value
+		n.right, _ = n.right.remove(successor.value, compare)
+		removed = true
+	}
+
+	return n.rebalance(), removed
+}
+
+func (n *node[A]) ascend(yield func(A) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return n.left.ascend(yield) && yield(n.value) && n.right.ascend(yield)
+}
+
+func (n *node[A]) descend(yield func(A) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return n.right.descend(yield) && yield(n.value) && n.left.descend(yield)
+}
+
+func (n *node[A]) ascendRange(lo, hi A, compare func(a, b A) int, yield func(A) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := compare(n.value, lo)
+	belowHi := compare(n.value, hi)
+
+	if aboveLo > 0 && !n.left.ascendRange(lo, hi, compare, yield) {
+		return false
+	}
+
+	if aboveLo >= 0 && belowHi < 0 && !yield(n.value) {
+		return false
+	}
+
+	if belowHi < 0 {
+		return n.right.ascendRange(lo, hi, compare, yield)
+	}
+
+	return true
+}
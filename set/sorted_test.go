@@ -0,0 +1,250 @@
+package set_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz/maybe"
+	"github.com/dustin10/itrz/set"
+)
+
+func Test_NewSorted(t *testing.T) {
+	s := set.NewSorted[int]()
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Len())
+}
+
+func Test_SortedFromSlice(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected []int
+	}{
+		"empty":      {values: []int{}, expected: []int{}},
+		"nil":        {values: nil, expected: []int{}},
+		"one":        {values: []int{1}, expected: []int{1}},
+		"unordered":  {values: []int{3, 1, 4, 2}, expected: []int{1, 2, 3, 4}},
+		"duplicates": {values: []int{1, 2, 3, 1, 4, 3}, expected: []int{1, 2, 3, 4}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.SortedFromSlice(test.values)
+
+			assert.Equal(t, len(test.expected), s.Len())
+			assert.Equal(t, test.expected, s.All().ToSlice())
+		})
+	}
+}
+
+func Test_NewSortedFunc_Reverse(t *testing.T) {
+	s := set.NewSortedFunc(func(a, b int) int { return cmp.Compare(b, a) }, set.WithInitialCapacity(4))
+	s.Add(1)
+	s.Add(3)
+	s.Add(2)
+
+	assert.Equal(t, []int{3, 2, 1}, s.All().ToSlice())
+}
+
+func Test_NewSortedFunc_NilComparator(t *testing.T) {
+	assert.Panics(t, func() { set.NewSortedFunc[int](nil) })
+}
+
+func Test_NewSortedFunc(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+
+	s := set.NewSortedFunc(func(a, b person) int { return cmp.Compare(a.age, b.age) })
+	s.Add(person{name: "b", age: 30})
+	s.Add(person{name: "a", age: 20})
+	s.Add(person{name: "c", age: 30})
+
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, []person{{name: "a", age: 20}, {name: "b", age: 30}}, s.All().ToSlice())
+}
+
+func Test_SortedSet_Remove(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		remove   int
+		expect   bool
+		expected []int
+	}{
+		"remove empty":          {values: []int{}, remove: 1, expected: []int{}},
+		"remove does not exist": {values: []int{2, 3}, remove: 1, expected: []int{2, 3}},
+		"remove leaf":           {values: []int{2, 1, 3}, remove: 1, expect: true, expected: []int{2, 3}},
+		"remove root":           {values: []int{2, 1, 3}, remove: 2, expect: true, expected: []int{1, 3}},
+		"remove only":           {values: []int{1}, remove: 1, expect: true, expected: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.SortedFromSlice(test.values)
+
+			assert.Equal(t, test.expect, s.Remove(test.remove))
+			assert.Equal(t, test.expected, s.All().ToSlice())
+			assert.Equal(t, len(test.expected), s.Len())
+		})
+	}
+}
+
+func Test_SortedSet_Contains(t *testing.T) {
+	s := set.SortedFromSlice([]int{5, 1, 3})
+
+	assert.True(t, s.Contains(1))
+	assert.True(t, s.Contains(3))
+	assert.True(t, s.Contains(5))
+	assert.False(t, s.Contains(2))
+}
+
+func Test_SortedSet_Clear(t *testing.T) {
+	s := set.SortedFromSlice([]int{1, 2, 3})
+
+	assert.Equal(t, 3, s.Clear())
+	assert.True(t, s.IsEmpty())
+	assert.Empty(t, s.All().ToSlice())
+}
+
+func Test_SortedSet_Backward(t *testing.T) {
+	s := set.SortedFromSlice([]int{2, 4, 1, 3})
+
+	assert.Equal(t, []int{4, 3, 2, 1}, s.Backward().ToSlice())
+	assert.Equal(t, []int{4, 3}, s.Backward().Limit(2).ToSlice())
+}
+
+func Test_SortedSet_All_EarlyTermination(t *testing.T) {
+	s := set.SortedFromSlice([]int{5, 4, 3, 2, 1})
+
+	assert.Equal(t, []int{1, 2}, s.All().Limit(2).ToSlice())
+}
+
+func Test_SortedSet_MinMax(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		min    maybe.Maybe[int]
+		max    maybe.Maybe[int]
+	}{
+		"empty": {values: []int{}, min: maybe.Nothing[int](), max: maybe.Nothing[int]()},
+		"one":   {values: []int{1}, min: maybe.Just(1), max: maybe.Just(1)},
+		"many":  {values: []int{3, 1, 5, 2}, min: maybe.Just(1), max: maybe.Just(5)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := set.SortedFromSlice(test.values)
+
+			assert.Equal(t, test.min, s.Min())
+			assert.Equal(t, test.max, s.Max())
+		})
+	}
+}
+
+func Test_SortedSet_FloorCeiling(t *testing.T) {
+	s := set.SortedFromSlice([]int{10, 20, 30})
+
+	tests := map[string]struct {
+		value   int
+		floor   maybe.Maybe[int]
+		ceiling maybe.Maybe[int]
+	}{
+		"below min": {value: 5, floor: maybe.Nothing[int](), ceiling: maybe.Just(10)},
+		"exact":     {value: 20, floor: maybe.Just(20), ceiling: maybe.Just(20)},
+		"between":   {value: 25, floor: maybe.Just(20), ceiling: maybe.Just(30)},
+		"above max": {value: 35, floor: maybe.Just(30), ceiling: maybe.Nothing[int]()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.floor, s.Floor(test.value))
+			assert.Equal(t, test.ceiling, s.Ceiling(test.value))
+		})
+	}
+}
+
+func Test_SortedSet_Range(t *testing.T) {
+	s := set.SortedFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	tests := map[string]struct {
+		lo, hi   int
+		expected []int
+	}{
+		"all":          {lo: 0, hi: 100, expected: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		"inner":        {lo: 3, hi: 6, expected: []int{3, 4, 5}},
+		"between":      {lo: 0, hi: 2, expected: []int{1}},
+		"empty range":  {lo: 4, hi: 4, expected: []int{}},
+		"inverted":     {lo: 6, hi: 3, expected: []int{}},
+		"out of range": {lo: 10, hi: 20, expected: []int{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, s.Range(test.lo, test.hi).ToSlice())
+		})
+	}
+}
+
+func Test_SortedSet_String(t *testing.T) {
+	s := set.SortedFromSlice([]int{3, 1, 2})
+
+	assert.Equal(t, "[1,2,3]", s.String())
+}
+
+func Test_SortedSet_MarshalJSON(t *testing.T) {
+	s := set.SortedFromSlice([]int{3, 1, 2})
+
+	res, err := json.Marshal(s)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("[1,2,3]"), res)
+}
+
+func Test_SortedSet_UnmarshalJSON(t *testing.T) {
+	s := set.NewSorted[int]()
+
+	err := json.Unmarshal([]byte("[3,1,2,1]"), s)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, s.All().ToSlice())
+
+	var zero set.SortedSet[int]
+
+	assert.NotNil(t, json.Unmarshal([]byte("[1]"), &zero))
+	assert.NotNil(t, json.Unmarshal([]byte("{}"), s))
+}
+
+func Test_SortedSet_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	s := set.NewSorted[int]()
+	expected := make(map[int]struct{})
+
+	for range 5000 {
+		n := r.IntN(500)
+
+		if r.IntN(3) == 0 {
+			_, exists := expected[n]
+			assert.Equal(t, exists, s.Remove(n))
+			delete(expected, n)
+		} else {
+			s.Add(n)
+			expected[n] = struct{}{}
+		}
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	assert.Equal(t, len(keys), s.Len())
+	assert.Equal(t, keys, s.All().ToSlice())
+}
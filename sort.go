@@ -0,0 +1,268 @@
+package itrz
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	"github.com/dustin10/itrz/maybe"
+)
+
+// IsSorted returns true if the elements yielded by the Seq are in ascending order.
+func IsSorted[A cmp.Ordered](seq Seq[A]) bool {
+	return IsSortedFunc(seq, cmp.Compare[A])
+}
+
+// IsSortedFunc returns true if the elements yielded by the Seq are in ascending order as defined
+// by the specified comparison function.
+func IsSortedFunc[A any](seq Seq[A], compare func(a, b A) int) bool {
+	first := true
+
+	var last A
+	for a := range seq {
+		if !first && compare(last, a) > 0 {
+			return false
+		}
+
+		first = false
+		last = a
+	}
+
+	return true
+}
+
+// Max returns a maybe.Maybe containing the largest element yielded by the Seq, or an empty one
+// if the Seq has no elements. If there are multiple largest elements then the first one is
+// returned.
+func Max[A cmp.Ordered](seq Seq[A]) maybe.Maybe[A] {
+	return MaxBy(seq, cmp.Compare[A])
+}
+
+// MaxBy returns a maybe.Maybe containing the largest element yielded by the Seq as defined by
+// the specified comparison function, or an empty one if the Seq has no elements. If there are
+// multiple largest elements then the first one is returned.
+func MaxBy[A any](seq Seq[A], compare func(a, b A) int) maybe.Maybe[A] {
+	return MinBy(seq, func(a, b A) int {
+		return compare(b, a)
+	})
+}
+
+// MergeSorted merges the specified sequences, each of which must already be in ascending order,
+// into one Seq that yields all of their elements in ascending order. Elements that compare
+// equal are yielded in the order of the sequences they came from.
+func MergeSorted[A cmp.Ordered](seqs ...Seq[A]) Seq[A] {
+	return MergeSortedFunc(cmp.Compare[A], seqs...)
+}
+
+// MergeSortedFunc merges the specified sequences, each of which must already be in ascending
+// order as defined by the specified comparison function, into one Seq that yields all of their
+// elements in that order. Elements that compare equal are yielded in the order of the sequences
+// they came from.
+func MergeSortedFunc[A any](compare func(a, b A) int, seqs ...Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		h := &mergeHeap[A]{
+			compare: compare,
+		}
+
+		for idx, seq := range seqs {
+			next, stop := Pull(seq)
+			defer stop()
+
+			if a, exists := next(); exists {
+				h.heads = append(h.heads, mergeHead[A]{value: a, idx: idx, next: next})
+			}
+		}
+
+		heap.Init(h)
+
+		for h.Len() > 0 {
+			head := &h.heads[0]
+
+			if !yield(head.value) {
+				return
+			}
+
+			if a, exists := head.next(); exists {
+				head.value = a
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
+
+// Min returns a maybe.Maybe containing the smallest element yielded by the Seq, or an empty one
+// if the Seq has no elements. If there are multiple smallest elements then the first one is
+// returned.
+func Min[A cmp.Ordered](seq Seq[A]) maybe.Maybe[A] {
+	return MinBy(seq, cmp.Compare[A])
+}
+
+// MinBy returns a maybe.Maybe containing the smallest element yielded by the Seq as defined by
+// the specified comparison function, or an empty one if the Seq has no elements. If there are
+// multiple smallest elements then the first one is returned.
+func MinBy[A any](seq Seq[A], compare func(a, b A) int) maybe.Maybe[A] {
+	result := maybe.Nothing[A]()
+
+	for a := range seq {
+		if result.IsEmpty() || compare(a, result.Get()) < 0 {
+			result = maybe.Just(a)
+		}
+	}
+
+	return result
+}
+
+// MinMax returns maybe.Maybes containing the smallest and largest elements yielded by the Seq in a
+// single pass, or empty ones if the Seq has no elements. If there are multiple smallest or largest
+// elements then the first one is returned.
+func MinMax[A cmp.Ordered](seq Seq[A]) (maybe.Maybe[A], maybe.Maybe[A]) {
+	return MinMaxBy(seq, cmp.Compare[A])
+}
+
+// MinMaxBy returns maybe.Maybes containing the smallest and largest elements yielded by the Seq as
+// defined by the specified comparison function in a single pass, or empty ones if the Seq has no
+// elements. If there are multiple smallest or largest elements then the first one is returned.
+func MinMaxBy[A any](seq Seq[A], compare func(a, b A) int) (maybe.Maybe[A], maybe.Maybe[A]) {
+	empty := true
+
+	var lo, hi A
+	for a := range seq {
+		if empty {
+			lo, hi = a, a
+			empty = false
+
+			continue
+		}
+
+		if compare(a, lo) < 0 {
+			lo = a
+		}
+
+		if compare(a, hi) > 0 {
+			hi = a
+		}
+	}
+
+	if empty {
+		return maybe.Nothing[A](), maybe.Nothing[A]()
+	}
+
+	return maybe.Just(lo), maybe.Just(hi)
+}
+
+// Sorted returns a Seq that yields the elements of the specified Seq in ascending order. The
+// source Seq is fully consumed when iteration starts, so it must be finite. The sort is stable.
+func Sorted[A cmp.Ordered](seq Seq[A]) Seq[A] {
+	return SortedFunc(seq, cmp.Compare[A])
+}
+
+// SortedFunc returns a Seq that yields the elements of the specified Seq in ascending order as
+// defined by the specified comparison function. The source Seq is fully consumed when iteration
+// starts, so it must be finite. The sort is stable, so elements that compare equal keep their
+// original order.
+func SortedFunc[A any](seq Seq[A], compare func(a, b A) int) Seq[A] {
+	return func(yield func(A) bool) {
+		as := seq.ToSlice()
+		slices.SortStableFunc(as, compare)
+
+		for _, a := range as {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// TopK returns a Seq that yields the k largest elements of the specified Seq, as defined by the
+// specified comparison function, in descending order. The source Seq is fully consumed when
+// iteration starts, but at most k elements are held in memory at any time. Panics if k is
+// negative.
+func TopK[A any](seq Seq[A], k int, compare func(a, b A) int) Seq[A] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+
+	return func(yield func(A) bool) {
+		if k == 0 {
+			return
+		}
+
+		h := &topKHeap[A]{
+			compare: compare,
+			elems:   make([]A, 0),
+		}
+
+		for a := range seq {
+			if h.Len() < k {
+				heap.Push(h, a)
+			} else if compare(a, h.elems[0]) > 0 {
+				h.elems[0] = a
+				heap.Fix(h, 0)
+			}
+		}
+
+		as := make([]A, h.Len())
+		for i := len(as) - 1; i >= 0; i-- {
+			as[i] = heap.Pop(h).(A)
+		}
+
+		for _, a := range as {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// topKHeap is a min-heap used by TopK to retain the k largest elements seen so far.
+type topKHeap[A any] struct {
+	compare func(a, b A) int
+	elems   []A
+}
+
+func (h *topKHeap[A]) Len() int           { return len(h.elems) }
+func (h *topKHeap[A]) Less(i, j int) bool { return h.compare(h.elems[i], h.elems[j]) < 0 }
+func (h *topKHeap[A]) Swap(i, j int)      { h.elems[i], h.elems[j] = h.elems[j], h.elems[i] }
+func (h *topKHeap[A]) Push(x any)         { h.elems = append(h.elems, x.(A)) }
+
+func (h *topKHeap[A]) Pop() any {
+	last := h.elems[len(h.elems)-1]
+	h.elems = h.elems[:len(h.elems)-1]
+
+	return last
+}
+
+// mergeHead is the current head element of one of the sequences being merged by MergeSortedFunc.
+type mergeHead[A any] struct {
+	value A
+	idx   int
+	next  func() (A, bool)
+}
+
+// mergeHeap is a min-heap of the head elements of the sequences being merged by MergeSortedFunc.
+// Ties are broken by the position of the sequence so that the merge is stable.
+type mergeHeap[A any] struct {
+	compare func(a, b A) int
+	heads   []mergeHead[A]
+}
+
+func (h *mergeHeap[A]) Len() int      { return len(h.heads) }
+func (h *mergeHeap[A]) Swap(i, j int) { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+func (h *mergeHeap[A]) Push(x any)    { h.heads = append(h.heads, x.(mergeHead[A])) }
+
+func (h *mergeHeap[A]) Less(i, j int) bool {
+	if c := h.compare(h.heads[i].value, h.heads[j].value); c != 0 {
+		return c < 0
+	}
+
+	return h.heads[i].idx < h.heads[j].idx
+}
+
+func (h *mergeHeap[A]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+
+	return last
+}
@@ -0,0 +1,203 @@
+package itrz_test
+
+import (
+	"cmp"
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+	"github.com/dustin10/itrz/maybe"
+)
+
+func Test_IsSorted(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected bool
+	}{
+		"empty":      {values: []int{}, expected: true},
+		"nil":        {values: nil, expected: true},
+		"one":        {values: []int{1}, expected: true},
+		"sorted":     {values: []int{1, 2, 2, 3}, expected: true},
+		"not sorted": {values: []int{1, 3, 2}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, itrz.IsSorted(itrz.All(test.values)))
+		})
+	}
+}
+
+func Test_IsSortedFunc(t *testing.T) {
+	assert.True(t, itrz.IsSortedFunc(itrz.Of(3, 2, 1), descending))
+	assert.False(t, itrz.IsSortedFunc(itrz.Of(1, 2, 3), descending))
+}
+
+func Test_MinMax(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		min    maybe.Maybe[int]
+		max    maybe.Maybe[int]
+	}{
+		"empty": {values: []int{}, min: maybe.Nothing[int](), max: maybe.Nothing[int]()},
+		"nil":   {values: nil, min: maybe.Nothing[int](), max: maybe.Nothing[int]()},
+		"one":   {values: []int{1}, min: maybe.Just(1), max: maybe.Just(1)},
+		"many":  {values: []int{3, -1, 5, 2}, min: maybe.Just(-1), max: maybe.Just(5)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.min, itrz.Min(itrz.All(test.values)))
+			assert.Equal(t, test.max, itrz.Max(itrz.All(test.values)))
+
+			lo, hi := itrz.MinMax(itrz.All(test.values))
+
+			assert.Equal(t, test.min, lo)
+			assert.Equal(t, test.max, hi)
+		})
+	}
+}
+
+func Test_MinByMaxBy(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+
+	byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+	s := itrz.Of(item{2, "a"}, item{1, "b"}, item{3, "c"}, item{1, "d"}, item{3, "e"})
+
+	assert.Equal(t, maybe.Just(item{1, "b"}), itrz.MinBy(s, byKey))
+	assert.Equal(t, maybe.Just(item{3, "c"}), itrz.MaxBy(s, byKey))
+	assert.True(t, itrz.MinBy(itrz.Empty[item](), byKey).IsEmpty())
+	assert.True(t, itrz.MaxBy(itrz.Empty[item](), byKey).IsEmpty())
+
+	lo, hi := itrz.MinMaxBy(s, byKey)
+
+	assert.Equal(t, maybe.Just(item{1, "b"}), lo)
+	assert.Equal(t, maybe.Just(item{3, "c"}), hi)
+}
+
+func Test_Sorted(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		expected []int
+	}{
+		"empty":    {values: []int{}, expected: []int{}},
+		"nil":      {values: nil, expected: []int{}},
+		"sorted":   {values: []int{1, 2, 3}, expected: []int{1, 2, 3}},
+		"unsorted": {values: []int{3, 1, 2, 1}, expected: []int{1, 1, 2, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, consumeSeq(itrz.Sorted(itrz.All(test.values))))
+		})
+	}
+}
+
+func Test_SortedFunc_Stable(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+
+	s := itrz.Of(item{2, "a"}, item{1, "b"}, item{2, "c"}, item{1, "d"})
+
+	res := consumeSeq(itrz.SortedFunc(s, func(a, b item) int { return cmp.Compare(a.key, b.key) }))
+
+	assert.Equal(t, []item{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}, res)
+	assert.Equal(t, []int{3, 2}, itrz.SortedFunc(itrz.Of(1, 2, 3), descending).Limit(2).ToSlice())
+}
+
+func Test_TopK(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		k        int
+		expected []int
+	}{
+		"empty":        {values: []int{}, k: 2, expected: []int{}},
+		"zero":         {values: []int{1, 2}, k: 0, expected: []int{}},
+		"fewer than k": {values: []int{2, 1}, k: 3, expected: []int{2, 1}},
+		"k":            {values: []int{5, 1, 9, 3, 7, 2}, k: 3, expected: []int{9, 7, 5}},
+		"duplicates":   {values: []int{5, 5, 1, 5}, k: 2, expected: []int{5, 5}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := consumeSeq(itrz.TopK(itrz.All(test.values), test.k, cmp.Compare[int]))
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func Test_TopK_ReversedComparator(t *testing.T) {
+	res := itrz.TopK(itrz.Of(5, 1, 9, 3), 2, descending).ToSlice()
+
+	assert.Equal(t, []int{1, 3}, res)
+}
+
+func Test_TopK_LargeK(t *testing.T) {
+	assert.Equal(t, []int{3, 2, 1}, itrz.TopK(itrz.Of(3, 1, 2), math.MaxInt, cmp.Compare[int]).ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, itrz.TopK(itrz.Of(3, 1, 2), 1e9, cmp.Compare[int]).ToSlice())
+}
+
+func Test_TopK_NegativeK(t *testing.T) {
+	assert.Panics(t, func() { itrz.TopK(itrz.Of(1), -1, cmp.Compare[int]) })
+}
+
+func Test_MergeSorted(t *testing.T) {
+	tests := map[string]struct {
+		seqs     []itrz.Seq[int]
+		expected []int
+	}{
+		"none":      {seqs: nil, expected: []int{}},
+		"all empty": {seqs: []itrz.Seq[int]{itrz.Empty[int](), itrz.Empty[int]()}, expected: []int{}},
+		"one":       {seqs: []itrz.Seq[int]{itrz.Of(1, 2, 3)}, expected: []int{1, 2, 3}},
+		"many":      {seqs: []itrz.Seq[int]{itrz.Of(1, 4, 7), itrz.Of(2, 5, 8), itrz.Of(3, 6, 9)}, expected: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		"uneven":    {seqs: []itrz.Seq[int]{itrz.Of(1), itrz.Empty[int](), itrz.Of(0, 2, 2, 10)}, expected: []int{0, 1, 2, 2, 10}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, consumeSeq(itrz.MergeSorted(test.seqs...)))
+		})
+	}
+}
+
+func Test_MergeSortedFunc_Stable(t *testing.T) {
+	type item struct {
+		key   int
+		shard string
+	}
+
+	byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+	s1 := itrz.Of(item{1, "a"}, item{2, "a"})
+	s2 := itrz.Of(item{1, "b"}, item{2, "b"})
+
+	res := consumeSeq(itrz.MergeSortedFunc(byKey, s1, s2))
+
+	assert.Equal(t, []item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, res)
+}
+
+func Test_MergeSorted_EarlyTermination(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	evens := itrz.GenerateWithLast(0, func(n int) int { return n + 2 })
+	odds := itrz.GenerateWithLast(-1, func(n int) int { return n + 2 })
+
+	res := itrz.MergeSorted(evens, odds).Limit(5).ToSlice()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, res)
+	assertNoGoroutineLeak(t, before)
+}
+
+func descending(a, b int) int {
+	return cmp.Compare(b, a)
+}
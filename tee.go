@@ -0,0 +1,196 @@
+package itrz
+
+import (
+	"errors"
+	"sync"
+)
+
+// defaultTeeBufferSize defines the default maximum number of elements buffered by Tee.
+const defaultTeeBufferSize = 1024
+
+// ErrTeeBufferFull is the value Tee panics with when one of its consumers falls more than the
+// configured buffer size behind the consumer that is furthest ahead.
+var ErrTeeBufferFull = errors.New("tee buffer is full")
+
+// TeeOption defines a function that can be used to customize the configuration used by Tee.
+type TeeOption func(config *TeeConfig)
+
+// TeeConfig contains the supported configuration of Tee.
+type TeeConfig struct {
+	// BufferSize defines the maximum number of elements that are buffered for consumers that
+	// have fallen behind. A value of zero or less means the buffer is unbounded.
+	BufferSize int
+}
+
+// WithTeeBufferSize is a TeeOption that can be used to configure the maximum number of elements
+// that are buffered for consumers that have fallen behind.
+func WithTeeBufferSize(size int) TeeOption {
+	return func(config *TeeConfig) {
+		config.BufferSize = size
+	}
+}
+
+// Tee splits the specified Seq into n Seq values that each yield all of the elements of the
+// source, which is only iterated once. Elements that have been consumed by the consumer furthest
+// ahead are buffered until every other consumer has consumed them as well. If a consumer would
+// need to buffer more elements than the configured buffer size, which defaults to 1024, then the
+// consumer that is trying to advance panics with ErrTeeBufferFull. A consumer that stops iterating
+// early no longer holds elements in the buffer. The returned Seq values may be iterated from
+// different goroutines, but each of them can only be iterated once.
+//
+// The source is driven by a pull-style iterator that is released once it is exhausted or once
+// every returned Seq has finished iterating. Panics if n is negative.
+func Tee[A any](seq Seq[A], n int, opts ...TeeOption) []Seq[A] {
+	if n < 0 {
+		panic("number of consumers must not be negative")
+	}
+
+	config := TeeConfig{
+		BufferSize: defaultTeeBufferSize,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	t := &tee[A]{
+		source:    seq,
+		config:    config,
+		positions: make([]int, n),
+		active:    make([]bool, n),
+		remaining: n,
+	}
+
+	seqs := make([]Seq[A], n)
+	for i := range n {
+		t.active[i] = true
+		seqs[i] = t.consumer(i)
+	}
+
+	return seqs
+}
+
+// tee holds the state shared by the consumers created by Tee.
+type tee[A any] struct {
+	mu        sync.Mutex
+	source    Seq[A]
+	config    TeeConfig
+	next      func() (A, bool)
+	stop      func()
+	exhausted bool
+	buffer    []A
+	base      int
+	positions []int
+	active    []bool
+	remaining int
+}
+
+func (t *tee[A]) consumer(i int) Seq[A] {
+	return func(yield func(A) bool) {
+		defer t.finish(i)
+
+		for {
+			a, exists := t.advance(i)
+			if !exists || !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// advance returns the next element for the consumer at index i.
+func (t *tee[A]) advance(i int) (A, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var zero A
+	if !t.active[i] {
+		return zero, false
+	}
+
+	pos := t.positions[i]
+
+	if pos == t.base+len(t.buffer) {
+		if t.exhausted {
+			return zero, false
+		}
+
+		if t.config.BufferSize > 0 && len(t.buffer) >= t.config.BufferSize && t.slowest() < pos {
+			panic(ErrTeeBufferFull)
+		}
+
+		if t.next == nil {
+			t.next, t.stop = Pull(t.source)
+		}
+
+		a, exists := t.next()
+		if !exists {
+			t.exhausted = true
+			t.stop()
+
+			return zero, false
+		}
+
+		t.buffer = append(t.buffer, a)
+	}
+
+	a := t.buffer[pos-t.base]
+	t.positions[i] = pos + 1
+	t.trim()
+
+	return a, true
+}
+
+// finish marks the consumer at index i as no longer consuming elements.
+func (t *tee[A]) finish(i int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active[i] {
+		return
+	}
+
+	t.active[i] = false
+	t.remaining = t.remaining - 1
+
+	if t.remaining == 0 {
+		t.buffer = nil
+
+		if t.stop != nil && !t.exhausted {
+			t.exhausted = true
+			t.stop()
+		}
+
+		return
+	}
+
+	t.trim()
+}
+
+// slowest returns the position of the active consumer that is furthest behind.
+func (t *tee[A]) slowest() int {
+	slowest := t.base + len(t.buffer)
+	for i, pos := range t.positions {
+		if t.active[i] && pos < slowest {
+			slowest = pos
+		}
+	}
+
+	return slowest
+}
+
+// trim drops the buffered elements that have been consumed by every active consumer.
+func (t *tee[A]) trim() {
+	drop := t.slowest() - t.base
+	if drop == 0 {
+		return
+	}
+
+	var zero A
+	for i := range drop {
+		t.buffer[i] = zero
+	}
+
+	t.buffer = t.buffer[drop:]
+	t.base = t.base + drop
+}
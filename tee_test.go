@@ -0,0 +1,104 @@
+package itrz_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_Tee(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		n      int
+	}{
+		"empty":    {values: []int{}, n: 2},
+		"none":     {values: []int{1, 2}, n: 0},
+		"one":      {values: []int{1, 2, 3}, n: 1},
+		"multiple": {values: []int{1, 2, 3}, n: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			source := itrz.All(test.values).Peek(func(int) { calls = calls + 1 })
+
+			seqs := itrz.Tee(source, test.n)
+
+			assert.Equal(t, test.n, len(seqs))
+
+			for _, s := range seqs {
+				assert.Equal(t, test.values, consumeSeq(s))
+			}
+
+			if test.n > 0 {
+				assert.Equal(t, len(test.values), calls)
+			}
+		})
+	}
+}
+
+func Test_Tee_LockStep(t *testing.T) {
+	seqs := itrz.Tee(itrz.GenerateWithLast(0, func(n int) int { return n + 1 }), 2, itrz.WithTeeBufferSize(1))
+
+	res := itrz.Map2(itrz.Zip(seqs[0], seqs[1]), func(a, b int) int { return a + b }).Limit(3).ToSlice()
+
+	assert.Equal(t, []int{2, 4, 6}, res)
+}
+
+func Test_Tee_BufferFull(t *testing.T) {
+	seqs := itrz.Tee(itrz.Of(1, 2, 3, 4), 2, itrz.WithTeeBufferSize(2))
+
+	assert.PanicsWithValue(t, itrz.ErrTeeBufferFull, func() { seqs[0].ToSlice() })
+}
+
+func Test_Tee_Unbounded(t *testing.T) {
+	seqs := itrz.Tee(itrz.Of(1, 2, 3, 4), 2, itrz.WithTeeBufferSize(0))
+
+	assert.Equal(t, []int{1, 2, 3, 4}, seqs[0].ToSlice())
+	assert.Equal(t, []int{1, 2, 3, 4}, seqs[1].ToSlice())
+}
+
+func Test_Tee_EarlyTermination(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	seqs := itrz.Tee(itrz.Generate(func() int { return 1 }), 2, itrz.WithTeeBufferSize(4))
+
+	// the first consumer stopping releases its hold on the buffer
+	assert.Equal(t, 2, seqs[0].Limit(2).Count())
+	assert.Equal(t, 10, seqs[1].Limit(10).Count())
+
+	assertNoGoroutineLeak(t, before)
+}
+
+func Test_Tee_SingleUse(t *testing.T) {
+	seqs := itrz.Tee(itrz.Of(1, 2, 3), 1)
+
+	assert.Equal(t, []int{1, 2, 3}, seqs[0].ToSlice())
+	assert.Empty(t, seqs[0].ToSlice())
+}
+
+func Test_Tee_Concurrent(t *testing.T) {
+	values := itrz.GenerateWithLast(0, func(n int) int { return n + 1 }).Limit(100).ToSlice()
+
+	seqs := itrz.Tee(itrz.All(values), 4, itrz.WithTeeBufferSize(0))
+
+	var wg sync.WaitGroup
+	for _, s := range seqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			assert.Equal(t, values, s.ToSlice())
+		}()
+	}
+
+	wg.Wait()
+}
+
+func Test_Tee_NegativeN(t *testing.T) {
+	assert.Panics(t, func() { itrz.Tee(itrz.Of(1), -1) })
+}
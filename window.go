@@ -0,0 +1,113 @@
+package itrz
+
+import (
+	"slices"
+)
+
+// WindowOption defines a function that can be used to customize the configuration used
+// when creating a windowed Seq.
+type WindowOption func(config *WindowConfig)
+
+// WindowConfig contains the supported configuration of a windowed Seq.
+type WindowConfig struct {
+	// Partial defines whether trailing windows that contain fewer elements than the window
+	// size are yielded.
+	Partial bool
+	// ReuseBuffer defines whether the same backing slice is reused for every window that is
+	// yielded instead of allocating a new one.
+	ReuseBuffer bool
+}
+
+// WithPartialWindows is a WindowOption that causes trailing windows containing fewer elements
+// than the window size to be yielded once the source Seq is exhausted.
+func WithPartialWindows() WindowOption {
+	return func(config *WindowConfig) {
+		config.Partial = true
+	}
+}
+
+// WithReuseBuffer is a WindowOption that causes a single buffer to be reused for every window
+// yielded. When enabled, a yielded slice is only valid until the next window is requested and
+// must be copied by the caller if it needs to be retained.
+func WithReuseBuffer() WindowOption {
+	return func(config *WindowConfig) {
+		config.ReuseBuffer = true
+	}
+}
+
+// Chunk returns a Seq that yields the elements of the specified Seq in consecutive, non-overlapping
+// slices of length n. The final slice will contain fewer than n elements if the number of elements
+// in the Seq is not evenly divisible by n. Panics if n is not positive.
+func Chunk[A any](seq Seq[A], n int, opts ...WindowOption) Seq[[]A] {
+	return Window(seq, n, n, append([]WindowOption{WithPartialWindows()}, opts...)...)
+}
+
+// Window returns a Seq that yields sliding windows of the specified size over the elements of
+// the Seq. A new window is started every step elements, so windows overlap when step is smaller
+// than size and elements are dropped between windows when step is larger than size. Trailing
+// windows that contain fewer than size elements are only yielded if the WithPartialWindows option
+// is specified. Panics if size or step is not positive.
+func Window[A any](seq Seq[A], size, step int, opts ...WindowOption) Seq[[]A] {
+	if size <= 0 {
+		panic("window size must be positive")
+	}
+
+	if step <= 0 {
+		panic("window step must be positive")
+	}
+
+	config := WindowConfig{}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	emit := func(buf []A) []A {
+		if config.ReuseBuffer {
+			return buf
+		}
+
+		return slices.Clone(buf)
+	}
+
+	return func(yield func([]A) bool) {
+		// grown on demand rather than allocated up front, as size may exceed the number of elements
+		var buf []A
+		skip := 0
+
+		for a := range seq {
+			if skip > 0 {
+				skip = skip - 1
+				continue
+			}
+
+			buf = append(buf, a)
+			if len(buf) < size {
+				continue
+			}
+
+			if !yield(emit(buf)) {
+				return
+			}
+
+			if step >= size {
+				buf = buf[:0]
+				skip = step - size
+			} else {
+				buf = append(buf[:0], buf[step:]...)
+			}
+		}
+
+		if !config.Partial {
+			return
+		}
+
+		for len(buf) > 0 {
+			if !yield(emit(buf)) || step >= len(buf) {
+				return
+			}
+
+			buf = append(buf[:0], buf[step:]...)
+		}
+	}
+}
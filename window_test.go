@@ -0,0 +1,120 @@
+package itrz_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dustin10/itrz"
+)
+
+func Test_Chunk(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		n        int
+		expected [][]int
+	}{
+		"empty":       {values: []int{}, n: 2, expected: [][]int{}},
+		"nil":         {values: nil, n: 2, expected: [][]int{}},
+		"even":        {values: []int{1, 2, 3, 4}, n: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		"uneven":      {values: []int{1, 2, 3, 4, 5}, n: 2, expected: [][]int{{1, 2}, {3, 4}, {5}}},
+		"larger than": {values: []int{1, 2}, n: 3, expected: [][]int{{1, 2}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.Chunk(itrz.All(test.values), test.n)
+
+			assert.Equal(t, test.expected, consumeSeq(s))
+		})
+	}
+}
+
+func Test_Chunk_InvalidSize(t *testing.T) {
+	assert.Panics(t, func() { itrz.Chunk(itrz.Of(1, 2, 3), 0) })
+}
+
+func Test_Chunk_SizeLargerThanSeq(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2, 3}}, itrz.Chunk(itrz.Of(1, 2, 3), math.MaxInt).ToSlice())
+	assert.Equal(t, [][]int{{1, 2, 3}}, itrz.Chunk(itrz.Of(1, 2, 3), 1e9, itrz.WithReuseBuffer()).ToSlice())
+	assert.Empty(t, itrz.Window(itrz.Of(1, 2, 3), math.MaxInt, 1).ToSlice())
+}
+
+func Test_Window(t *testing.T) {
+	tests := map[string]struct {
+		values   []int
+		size     int
+		step     int
+		opts     []itrz.WindowOption
+		expected [][]int
+	}{
+		"empty":                {values: []int{}, size: 2, step: 1, expected: [][]int{}},
+		"nil":                  {values: nil, size: 2, step: 1, expected: [][]int{}},
+		"sliding":              {values: []int{1, 2, 3, 4}, size: 2, step: 1, expected: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		"tumbling":             {values: []int{1, 2, 3, 4, 5}, size: 2, step: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		"hopping":              {values: []int{1, 2, 3, 4, 5, 6, 7}, size: 2, step: 3, expected: [][]int{{1, 2}, {4, 5}}},
+		"too short":            {values: []int{1, 2}, size: 3, step: 1, expected: [][]int{}},
+		"partial sliding":      {values: []int{1, 2, 3, 4}, size: 3, step: 1, opts: []itrz.WindowOption{itrz.WithPartialWindows()}, expected: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4}, {4}}},
+		"partial sliding step": {values: []int{1, 2, 3, 4, 5}, size: 3, step: 2, opts: []itrz.WindowOption{itrz.WithPartialWindows()}, expected: [][]int{{1, 2, 3}, {3, 4, 5}, {5}}},
+		"partial hopping":      {values: []int{1, 2, 3, 4, 5, 6, 7}, size: 2, step: 3, opts: []itrz.WindowOption{itrz.WithPartialWindows()}, expected: [][]int{{1, 2}, {4, 5}, {7}}},
+		"partial too short":    {values: []int{1, 2}, size: 3, step: 1, opts: []itrz.WindowOption{itrz.WithPartialWindows()}, expected: [][]int{{1, 2}, {2}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := itrz.Window(itrz.All(test.values), test.size, test.step, test.opts...)
+
+			assert.Equal(t, test.expected, consumeSeq(s))
+		})
+	}
+}
+
+func Test_Window_InvalidArguments(t *testing.T) {
+	assert.Panics(t, func() { itrz.Window(itrz.Of(1, 2, 3), 0, 1) })
+	assert.Panics(t, func() { itrz.Window(itrz.Of(1, 2, 3), 1, 0) })
+}
+
+func Test_Window_ReuseBuffer(t *testing.T) {
+	s := itrz.Window(itrz.Of(1, 2, 3, 4), 2, 1, itrz.WithReuseBuffer())
+
+	var first []int
+	res := make([][]int, 0)
+	for w := range s {
+		if first == nil {
+			first = w
+		}
+
+		assert.Same(t, &first[0], &w[0])
+
+		res = append(res, append([]int(nil), w...))
+	}
+
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, res)
+}
+
+func Test_Window_EarlyTermination(t *testing.T) {
+	pulled := 0
+	source := itrz.Of(1, 2, 3, 4, 5, 6).Peek(func(int) { pulled = pulled + 1 })
+
+	res := make([][]int, 0)
+	for w := range itrz.Chunk(source, 2) {
+		res = append(res, w)
+		if len(res) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, res)
+	assert.Equal(t, 4, pulled)
+}
+
+func Test_WindowOptions(t *testing.T) {
+	cfg := itrz.WindowConfig{}
+
+	itrz.WithPartialWindows()(&cfg)
+	itrz.WithReuseBuffer()(&cfg)
+
+	assert.True(t, cfg.Partial)
+	assert.True(t, cfg.ReuseBuffer)
+}